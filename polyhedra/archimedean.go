@@ -0,0 +1,191 @@
+package polyhedra
+
+import (
+	"math"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// The Archimedean solids are created from their vertex coordinates as listed on
+// https://en.wikipedia.org/wiki/Archimedean_solid, the faces are those of the convex hull of the vertices.
+
+// phi is the golden ratio.
+var phi = (1 + math.Sqrt(5)) / 2
+
+var (
+	evenPermutations = [][3]int{{0, 1, 2}, {1, 2, 0}, {2, 0, 1}}
+	oddPermutations  = [][3]int{{0, 2, 1}, {2, 1, 0}, {1, 0, 2}}
+	allPermutations  = append(append([][3]int(nil), evenPermutations...), oddPermutations...)
+)
+
+// anySigns accepts all sign combinations of a coordinate triple.
+func anySigns(minus int) bool { return true }
+
+// evenMinusSigns accepts the sign combinations of a coordinate triple with an even number of minus signs.
+func evenMinusSigns(minus int) bool { return minus%2 == 0 }
+
+// oddMinusSigns accepts the sign combinations of a coordinate triple with an odd number of minus signs.
+func oddMinusSigns(minus int) bool { return minus%2 == 1 }
+
+// permutedPoints returns the points whose coordinates are the given permutations of the coordinates, with all
+// combinations of signs that are accepted by signs. Duplicates that arise from coordinates that are zero are removed.
+func permutedPoints(coordinates [3]float64, permutations [][3]int, signs func(minus int) bool) []r3.Point {
+	points := make([]r3.Point, 0)
+	for _, perm := range permutations {
+		for s := 0; s < 8; s++ {
+			minus := 0
+			var c [3]float64
+			for i := range c {
+				c[i] = coordinates[perm[i]]
+				if s&(1<<uint(i)) != 0 {
+					c[i] = -c[i]
+					minus++
+				}
+			}
+			if !signs(minus) {
+				continue
+			}
+			p := r3.Point{X: c[0], Y: c[1], Z: c[2]}
+			duplicate := false
+			for _, q := range points {
+				if r3.Distance(p, q) < 1e-12 {
+					duplicate = true
+					break
+				}
+			}
+			if !duplicate {
+				points = append(points, p)
+			}
+		}
+	}
+	return points
+}
+
+// newArchimedeanSolid creates the convex Polyhedron with vertices at the given points, scaled to the given
+// circumradius.
+func newArchimedeanSolid(radius float64, points ...[]r3.Point) *Polyhedron {
+	positions := make([]r3.Point, 0)
+	for _, ps := range points {
+		for _, p := range ps {
+			positions = append(positions, onSphere(p, radius))
+		}
+	}
+	poly := newPolyhedronFromLoops(positions, convexHullLoops(positions))
+	return &poly
+}
+
+// NewTruncatedTetrahedron creates a truncated tetrahedron with the given circumradius that is centered at the origin.
+// It has 4 triangular and 4 hexagonal faces.
+func NewTruncatedTetrahedron(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius, permutedPoints([3]float64{3, 1, 1}, allPermutations, evenMinusSigns))
+}
+
+// NewCuboctahedron creates a cuboctahedron with the given circumradius that is centered at the origin.
+// It has 8 triangular and 6 square faces.
+func NewCuboctahedron(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius, permutedPoints([3]float64{1, 1, 0}, allPermutations, anySigns))
+}
+
+// NewTruncatedCube creates a truncated cube with the given circumradius that is centered at the origin.
+// It has 8 triangular and 6 octagonal faces.
+func NewTruncatedCube(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius, permutedPoints([3]float64{math.Sqrt2 - 1, 1, 1}, allPermutations, anySigns))
+}
+
+// NewTruncatedOctahedron creates a truncated octahedron with the given circumradius that is centered at the origin.
+// It has 6 square and 8 hexagonal faces.
+func NewTruncatedOctahedron(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius, permutedPoints([3]float64{0, 1, 2}, allPermutations, anySigns))
+}
+
+// NewRhombicuboctahedron creates a rhombicuboctahedron with the given circumradius that is centered at the origin.
+// It has 8 triangular and 18 square faces.
+func NewRhombicuboctahedron(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius, permutedPoints([3]float64{1, 1, 1 + math.Sqrt2}, allPermutations, anySigns))
+}
+
+// NewTruncatedCuboctahedron creates a truncated cuboctahedron with the given circumradius that is centered at the
+// origin. It has 12 square, 8 hexagonal and 6 octagonal faces.
+func NewTruncatedCuboctahedron(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius,
+		permutedPoints([3]float64{1, 1 + math.Sqrt2, 1 + 2*math.Sqrt2}, allPermutations, anySigns))
+}
+
+// NewSnubCube creates a snub cube with the given circumradius that is centered at the origin.
+// It has 32 triangular and 6 square faces.
+func NewSnubCube(radius float64) *Polyhedron {
+	// t is the tribonacci constant.
+	t := (1 + math.Cbrt(19+3*math.Sqrt(33)) + math.Cbrt(19-3*math.Sqrt(33))) / 3
+	coordinates := [3]float64{1, 1 / t, t}
+	return newArchimedeanSolid(radius,
+		permutedPoints(coordinates, evenPermutations, oddMinusSigns),
+		permutedPoints(coordinates, oddPermutations, evenMinusSigns))
+}
+
+// NewIcosidodecahedron creates an icosidodecahedron with the given circumradius that is centered at the origin.
+// It has 20 triangular and 12 pentagonal faces.
+func NewIcosidodecahedron(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius,
+		permutedPoints([3]float64{0, 0, phi}, evenPermutations, anySigns),
+		permutedPoints([3]float64{0.5, phi / 2, phi * phi / 2}, evenPermutations, anySigns))
+}
+
+// NewTruncatedDodecahedron creates a truncated dodecahedron with the given circumradius that is centered at the
+// origin. It has 20 triangular and 12 decagonal faces.
+func NewTruncatedDodecahedron(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius,
+		permutedPoints([3]float64{0, 1 / phi, 2 + phi}, evenPermutations, anySigns),
+		permutedPoints([3]float64{1 / phi, phi, 2 * phi}, evenPermutations, anySigns),
+		permutedPoints([3]float64{phi, 2, phi + 1}, evenPermutations, anySigns))
+}
+
+// NewTruncatedIcosahedron creates a truncated icosahedron with the given circumradius that is centered at the
+// origin. It has 12 pentagonal and 20 hexagonal faces.
+func NewTruncatedIcosahedron(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius,
+		permutedPoints([3]float64{0, 1, 3 * phi}, evenPermutations, anySigns),
+		permutedPoints([3]float64{1, 2 + phi, 2 * phi}, evenPermutations, anySigns),
+		permutedPoints([3]float64{phi, 2, phi * phi * phi}, evenPermutations, anySigns))
+}
+
+// NewRhombicosidodecahedron creates a rhombicosidodecahedron with the given circumradius that is centered at the
+// origin. It has 20 triangular, 30 square and 12 pentagonal faces.
+func NewRhombicosidodecahedron(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius,
+		permutedPoints([3]float64{1, 1, phi * phi * phi}, evenPermutations, anySigns),
+		permutedPoints([3]float64{phi * phi, phi, 2 * phi}, evenPermutations, anySigns),
+		permutedPoints([3]float64{2 + phi, 0, phi * phi}, evenPermutations, anySigns))
+}
+
+// NewTruncatedIcosidodecahedron creates a truncated icosidodecahedron with the given circumradius that is centered
+// at the origin. It has 30 square, 20 hexagonal and 12 decagonal faces.
+func NewTruncatedIcosidodecahedron(radius float64) *Polyhedron {
+	return newArchimedeanSolid(radius,
+		permutedPoints([3]float64{1 / phi, 1 / phi, 3 + phi}, evenPermutations, anySigns),
+		permutedPoints([3]float64{2 / phi, phi, 1 + 2*phi}, evenPermutations, anySigns),
+		permutedPoints([3]float64{1 / phi, phi * phi, 3*phi - 1}, evenPermutations, anySigns),
+		permutedPoints([3]float64{2*phi - 1, 2, 2 + phi}, evenPermutations, anySigns),
+		permutedPoints([3]float64{phi, 3, 2 * phi}, evenPermutations, anySigns))
+}
+
+// NewSnubDodecahedron creates a snub dodecahedron with the given circumradius that is centered at the origin.
+// It has 80 triangular and 12 pentagonal faces.
+func NewSnubDodecahedron(radius float64) *Polyhedron {
+	// xi is the real root of xi^3 - 2*xi = phi.
+	d := math.Sqrt(phi-5.0/27) / 2
+	xi := math.Cbrt(phi/2+d) + math.Cbrt(phi/2-d)
+	a := xi - 1/xi
+	b := xi*phi + phi*phi + phi/xi
+	points := make([][]r3.Point, 0, 5)
+	for _, coordinates := range [][3]float64{
+		{2 * a, 2, 2 * b},
+		{a + b/phi + phi, -a*phi + b + 1/phi, a/phi + b*phi - 1},
+		{-a/phi + b*phi + 1, -a + b/phi - phi, a*phi + b - 1/phi},
+		{-a/phi + b*phi - 1, a - b/phi - phi, a*phi + b + 1/phi},
+		{a + b/phi - phi, a*phi - b + 1/phi, a/phi + b*phi + 1},
+	} {
+		// An even number of plus signs among three non-zero coordinates means an odd number of minus signs.
+		points = append(points, permutedPoints(coordinates, evenPermutations, oddMinusSigns))
+	}
+	return newArchimedeanSolid(radius, points...)
+}
@@ -0,0 +1,53 @@
+package polyhedra
+
+import (
+	"testing"
+)
+
+func TestArchimedeanSolids(t *testing.T) {
+	solids := []struct {
+		name                   string
+		create                 func(radius float64) *Polyhedron
+		vertices, edges, faces int
+		// sides maps the number of sides of a Face to the number of such faces.
+		sides map[int]int
+	}{
+		{"truncated tetrahedron", NewTruncatedTetrahedron, 12, 18, 8, map[int]int{3: 4, 6: 4}},
+		{"cuboctahedron", NewCuboctahedron, 12, 24, 14, map[int]int{3: 8, 4: 6}},
+		{"truncated cube", NewTruncatedCube, 24, 36, 14, map[int]int{3: 8, 8: 6}},
+		{"truncated octahedron", NewTruncatedOctahedron, 24, 36, 14, map[int]int{4: 6, 6: 8}},
+		{"rhombicuboctahedron", NewRhombicuboctahedron, 24, 48, 26, map[int]int{3: 8, 4: 18}},
+		{"truncated cuboctahedron", NewTruncatedCuboctahedron, 48, 72, 26, map[int]int{4: 12, 6: 8, 8: 6}},
+		{"snub cube", NewSnubCube, 24, 60, 38, map[int]int{3: 32, 4: 6}},
+		{"icosidodecahedron", NewIcosidodecahedron, 30, 60, 32, map[int]int{3: 20, 5: 12}},
+		{"truncated dodecahedron", NewTruncatedDodecahedron, 60, 90, 32, map[int]int{3: 20, 10: 12}},
+		{"truncated icosahedron", NewTruncatedIcosahedron, 60, 90, 32, map[int]int{5: 12, 6: 20}},
+		{"rhombicosidodecahedron", NewRhombicosidodecahedron, 60, 120, 62, map[int]int{3: 20, 4: 30, 5: 12}},
+		{"truncated icosidodecahedron", NewTruncatedIcosidodecahedron, 120, 180, 62, map[int]int{4: 30, 6: 20, 10: 12}},
+		{"snub dodecahedron", NewSnubDodecahedron, 60, 150, 92, map[int]int{3: 80, 5: 12}},
+	}
+	for _, s := range solids {
+		t.Run(s.name, func(t *testing.T) {
+			p := s.create(2)
+			assertVertexCount(p, s.vertices, t)
+			assertEdgeCount(p, s.edges, t)
+			assertFaceCount(p, s.faces, t)
+			assertCircumradius(p, 2, t)
+			assertRegularEdges(p, t)
+			assertOutwardWinding(p, t)
+
+			sides := make(map[int]int)
+			for _, f := range p.Faces() {
+				sides[len(f.Loop())]++
+			}
+			for n, count := range s.sides {
+				if sides[n] != count {
+					t.Errorf("Found %v faces with %v sides instead of %v", sides[n], n, count)
+				}
+			}
+			if issues := NewIntegrityChecker().CheckIntegrity(p); len(issues) != 0 {
+				t.Errorf("Integrity issues: %v", issues)
+			}
+		})
+	}
+}
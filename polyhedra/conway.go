@@ -0,0 +1,447 @@
+package polyhedra
+
+import (
+	"math"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// The Conway operators derive a new Polyhedron from a seed, see https://en.wikipedia.org/wiki/Conway_polyhedron_notation.
+// Ambo, Truncate, Kis, Chamfer and Snub are built directly on the faces of the seed. The remaining operators are
+// compositions of these with the dual, which is taken by polar reciprocation to keep the faces planar. The seed needs
+// to be a closed manifold with consistently wound faces, the operators that involve the dual also need it to be
+// centered on the origin.
+//
+// The results are valid polyhedra for every such seed, but their faces are only guaranteed to be planar for seeds with
+// regular faces and congruent vertex figures, such as the Platonic and Archimedean solids and the uniform prisms.
+// For other seeds, for example most Johnson solids, the faces that replace the vertices and edges of the seed are in
+// general not planar. The integrity checker reports them as warnings of PlanarFacesCheck.
+
+// conwayShrink is the fraction of the distance towards the neighbouring vertex or Face center at which Truncate,
+// Chamfer and Snub place their new vertices.
+const conwayShrink = 1.0 / 3
+
+// conwayKind is the element of the seed that a vertex of the result belongs to.
+type conwayKind int
+
+const (
+	seedVertex conwayKind = iota
+	faceVertex
+	edgeVertex
+	directedEdgeVertex
+	cornerVertex
+)
+
+// conwayKey identifies a vertex of the result by the element of the seed that it belongs to. Depending on the kind
+// the face index and the vertices a and b are set.
+type conwayKey struct {
+	kind conwayKind
+	face int
+	a, b Vertex
+}
+
+// conwayBuilder collects the vertices and faces of the result of a Conway operator.
+type conwayBuilder struct {
+	orientedFaces
+	positions []r3.Point
+	indices   map[conwayKey]int
+	loops     [][]int
+}
+
+// newConwayBuilder returns a builder for an operator that is applied to the given seed.
+func newConwayBuilder(seed Interface) (*conwayBuilder, error) {
+	o, err := newOrientedFaces(seed)
+	if err != nil {
+		return nil, err
+	}
+	return &conwayBuilder{orientedFaces: o, indices: make(map[conwayKey]int)}, nil
+}
+
+// vertex adds a vertex with the given key and position unless there already is a vertex with that key.
+func (b *conwayBuilder) vertex(k conwayKey, position r3.Point) {
+	if _, ok := b.indices[k]; !ok {
+		b.indices[k] = len(b.positions)
+		b.positions = append(b.positions, position)
+	}
+}
+
+// face adds a Face through the vertices with the given keys.
+func (b *conwayBuilder) face(keys ...conwayKey) {
+	loop := make([]int, len(keys))
+	for i, k := range keys {
+		loop[i] = b.indices[k]
+	}
+	b.loops = append(b.loops, loop)
+}
+
+// build returns the Polyhedron with the collected vertices and faces. The faces keep the winding of their loops.
+func (b *conwayBuilder) build() (*Polyhedron, error) {
+	p := Polyhedron{}
+	p.init()
+	vertices := make([]Vertex, len(b.positions))
+	for i, pos := range b.positions {
+		vertices[i] = p.newVertex(pos)
+	}
+	edges := make([]Edge, 0)
+	edgeSet := make(map[Edge]bool)
+	for _, indices := range b.loops {
+		loop := make([]Vertex, len(indices))
+		for i, index := range indices {
+			loop[i] = vertices[index]
+		}
+		f := NewFace(loop)
+		for _, e := range f.Edges() {
+			if !edgeSet[e] {
+				edgeSet[e] = true
+				edges = append(edges, e)
+			}
+		}
+		p.addFace(f)
+	}
+	p.setEdges(edges)
+	if err := validate(p.Vertices(), p.Edges(), p.Faces()); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// position returns the position of the vertex of the seed.
+func (b *conwayBuilder) position(v Vertex) r3.Point {
+	return b.p.VertexPosition(v)
+}
+
+// between returns the point at the fraction t of the way from a to c.
+func between(a, c r3.Point, t float64) r3.Point {
+	return a.Add(a.VectorTo(c).Scale(t))
+}
+
+// faceCentroid returns the centroid of the vertices of the i-th Face of the seed.
+func (b *conwayBuilder) faceCentroid(i int) r3.Point {
+	loop := b.faces[i].Loop()
+	positions := make([]r3.Point, len(loop))
+	for j, v := range loop {
+		positions[j] = b.position(v)
+	}
+	return r3.Centroid3D(positions)
+}
+
+// raisedFaceCenter returns the point over the centroid of the i-th Face of the seed whose distance from the origin is
+// the mean distance of the vertices of the Face. Points that would end up below the Face stay on it.
+func (b *conwayBuilder) raisedFaceCenter(i int) r3.Point {
+	f := b.faces[i]
+	center := b.faceCentroid(i)
+	normal := faceNormal(b.p, f)
+	radius := 0.0
+	for _, v := range f.Loop() {
+		radius += r3.Distance(origin, b.position(v)) / float64(len(f.Loop()))
+	}
+	return center.Add(normal.Scale(math.Max(0, radius-normal.Dot(origin.VectorTo(center)))))
+}
+
+// corners adds a vertex for every corner of every Face of the seed, at the fraction t of the way from the vertex to
+// the centroid of the Face. If offsets is given, the vertices of the i-th Face are moved by its i-th element.
+func (b *conwayBuilder) corners(t float64, offsets []r3.Vector) {
+	for i, f := range b.faces {
+		center := b.faceCentroid(i)
+		for _, v := range f.Loop() {
+			position := between(b.position(v), center, t)
+			if offsets != nil {
+				position = position.Add(offsets[i])
+			}
+			b.vertex(conwayKey{kind: cornerVertex, face: i, a: v}, position)
+		}
+	}
+}
+
+// forEachEdge calls fn with every Edge of the seed as pair of directed edges, together with the faces to the left
+// and right of the first one.
+func (b *conwayBuilder) forEachEdge(fn func(a, c Vertex, left, right int)) {
+	for _, e := range b.p.Edges() {
+		a, c := e.Vertices()[0], e.Vertices()[1]
+		fn(a, c, b.faceOf[directedEdge{a, c}], b.faceOf[directedEdge{c, a}])
+	}
+}
+
+// forEachFan calls fn with every vertex of the seed and the faces and neighbours around it, see orientedFaces.fan.
+func (b *conwayBuilder) forEachFan(fn func(v Vertex, faces []int, neighbours []Vertex)) error {
+	for _, v := range b.p.Vertices() {
+		faces, neighbours, err := b.fan(v)
+		if err != nil {
+			return err
+		}
+		fn(v, faces, neighbours)
+	}
+	return nil
+}
+
+// polarDual returns the dual of the Polyhedron by polar reciprocation about its mean midsphere.
+func polarDual(p *Polyhedron, err error) (*Polyhedron, error) {
+	if err != nil {
+		return nil, err
+	}
+	return Dual(p, WithPlacement(PolarPlacement))
+}
+
+// Kis returns the seed with a pyramid raised on every Face, so that every n-gon is replaced by n triangles.
+// The apexes lie over the centroids of the faces, at the mean distance of the vertices of their Face from the origin.
+func Kis(seed Interface) (*Polyhedron, error) {
+	return kis(seed, 0)
+}
+
+// kis raises pyramids on the faces of the seed with the given number of sides, or on all faces if sides is 0.
+func kis(seed Interface, sides int) (*Polyhedron, error) {
+	b, err := newConwayBuilder(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range seed.Vertices() {
+		b.vertex(conwayKey{kind: seedVertex, a: v}, b.position(v))
+	}
+	for i, f := range b.faces {
+		loop := f.Loop()
+		keys := make([]conwayKey, len(loop))
+		for j, v := range loop {
+			keys[j] = conwayKey{kind: seedVertex, a: v}
+		}
+		if sides != 0 && len(loop) != sides {
+			b.face(keys...)
+			continue
+		}
+		apex := conwayKey{kind: faceVertex, face: i}
+		b.vertex(apex, b.raisedFaceCenter(i))
+		for j := range keys {
+			b.face(keys[j], keys[(j+1)%len(keys)], apex)
+		}
+	}
+	return b.build()
+}
+
+// Truncate returns the seed with every vertex cut off, so that every vertex is replaced by a Face and every n-gon
+// becomes a 2n-gon. The new vertices lie on the edges of the seed, a third of the way from their ends.
+func Truncate(seed Interface) (*Polyhedron, error) {
+	return truncate(seed, 0)
+}
+
+// truncate cuts off the vertices of the seed with the given degree, or all vertices if degree is 0.
+func truncate(seed Interface, degree int) (*Polyhedron, error) {
+	b, err := newConwayBuilder(seed)
+	if err != nil {
+		return nil, err
+	}
+	cut := func(v Vertex) bool { return degree == 0 || seed.VertexDegree(v) == degree }
+	for _, v := range seed.Vertices() {
+		if !cut(v) {
+			b.vertex(conwayKey{kind: seedVertex, a: v}, b.position(v))
+			continue
+		}
+		for _, w := range seed.AdjacentVertices(v) {
+			b.vertex(conwayKey{kind: directedEdgeVertex, a: v, b: w}, between(b.position(v), b.position(w), conwayShrink))
+		}
+	}
+	for _, f := range b.faces {
+		loop := f.Loop()
+		keys := make([]conwayKey, 0, 2*len(loop))
+		for j, v := range loop {
+			if !cut(v) {
+				keys = append(keys, conwayKey{kind: seedVertex, a: v})
+				continue
+			}
+			previous, next := loop[(j+len(loop)-1)%len(loop)], loop[(j+1)%len(loop)]
+			keys = append(keys, conwayKey{kind: directedEdgeVertex, a: v, b: previous},
+				conwayKey{kind: directedEdgeVertex, a: v, b: next})
+		}
+		b.face(keys...)
+	}
+	err = b.forEachFan(func(v Vertex, _ []int, neighbours []Vertex) {
+		if !cut(v) {
+			return
+		}
+		keys := make([]conwayKey, len(neighbours))
+		for j, w := range neighbours {
+			keys[j] = conwayKey{kind: directedEdgeVertex, a: v, b: w}
+		}
+		b.face(keys...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b.build()
+}
+
+// Ambo returns the rectification of the seed, which has a vertex at the midpoint of every Edge of the seed and a Face
+// for every Face and every vertex of the seed.
+func Ambo(seed Interface) (*Polyhedron, error) {
+	b, err := newConwayBuilder(seed)
+	if err != nil {
+		return nil, err
+	}
+	midpoint := func(a, c Vertex) conwayKey {
+		e := NewEdge(a, c).Vertices()
+		return conwayKey{kind: edgeVertex, a: e[0], b: e[1]}
+	}
+	b.forEachEdge(func(a, c Vertex, _, _ int) {
+		b.vertex(midpoint(a, c), between(b.position(a), b.position(c), 0.5))
+	})
+	for _, f := range b.faces {
+		loop := f.Loop()
+		keys := make([]conwayKey, len(loop))
+		for j, v := range loop {
+			keys[j] = midpoint(v, loop[(j+1)%len(loop)])
+		}
+		b.face(keys...)
+	}
+	err = b.forEachFan(func(v Vertex, _ []int, neighbours []Vertex) {
+		keys := make([]conwayKey, len(neighbours))
+		for j, w := range neighbours {
+			keys[j] = midpoint(v, w)
+		}
+		b.face(keys...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b.build()
+}
+
+// Chamfer returns the seed with every Edge replaced by a hexagon. The faces of the seed shrink a third of the way
+// towards their centroids and are raised such that the hexagons are planar for seeds with regular faces, the vertices
+// of the seed stay in place.
+func Chamfer(seed Interface) (*Polyhedron, error) {
+	c, _, err := chamfer(seed)
+	return c, err
+}
+
+// chamfer returns the chamfer of the seed together with the indices of the faces of the seed that each Face of the
+// result belongs to. A shrunk Face belongs to the Face it shrank from, a hexagon to the two faces at its Edge.
+func chamfer(seed Interface) (*Polyhedron, [][]int, error) {
+	b, err := newConwayBuilder(seed)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, v := range seed.Vertices() {
+		b.vertex(conwayKey{kind: seedVertex, a: v}, b.position(v))
+	}
+	b.corners(conwayShrink, b.chamferOffsets(conwayShrink))
+	corner := func(face int, v Vertex) conwayKey { return conwayKey{kind: cornerVertex, face: face, a: v} }
+	parents := make([][]int, 0, len(b.faces)+len(seed.Edges()))
+	for i, f := range b.faces {
+		loop := f.Loop()
+		keys := make([]conwayKey, len(loop))
+		for j, v := range loop {
+			keys[j] = corner(i, v)
+		}
+		b.face(keys...)
+		parents = append(parents, []int{i})
+	}
+	b.forEachEdge(func(a, c Vertex, left, right int) {
+		b.face(corner(left, c), corner(left, a), conwayKey{kind: seedVertex, a: a}, corner(right, a), corner(right, c),
+			conwayKey{kind: seedVertex, a: c})
+		parents = append(parents, []int{left, right})
+	})
+	p, err := b.build()
+	if err != nil {
+		return nil, nil, err
+	}
+	return p, parents, nil
+}
+
+// chamferOffsets returns how far the shrunk faces of a chamfer need to be raised along their normals so that the
+// hexagons lie in the planes that bisect the dihedral angles at the edges of the seed. This is exact for seeds with
+// regular faces and congruent dihedral angles per Face, otherwise the mean over the edges of a Face is used.
+func (b *conwayBuilder) chamferOffsets(t float64) []r3.Vector {
+	normals := make([]r3.Vector, len(b.faces))
+	for i, f := range b.faces {
+		normals[i] = faceNormal(b.p, f)
+	}
+	offsets := make([]r3.Vector, len(b.faces))
+	for i, f := range b.faces {
+		center := b.faceCentroid(i)
+		loop := f.Loop()
+		height := 0.0
+		for j, a := range loop {
+			c := loop[(j+1)%len(loop)]
+			bisector := normals[i].Add(normals[b.faceOf[directedEdge{c, a}]])
+			for _, v := range []Vertex{a, c} {
+				height -= t * b.position(v).VectorTo(center).Dot(bisector) / normals[i].Dot(bisector)
+			}
+		}
+		offsets[i] = normals[i].Scale(height / float64(2*len(loop)))
+	}
+	return offsets
+}
+
+// Snub returns the snub of the seed, in which the faces of the seed and a Face for every vertex of the seed are
+// separated by two triangles along every Edge. The faces of the seed shrink a third of the way towards their
+// centroids. The result has the handedness that is written sL in the notation of Conway.
+func Snub(seed Interface) (*Polyhedron, error) {
+	b, err := newConwayBuilder(seed)
+	if err != nil {
+		return nil, err
+	}
+	b.corners(conwayShrink, nil)
+	corner := func(face int, v Vertex) conwayKey { return conwayKey{kind: cornerVertex, face: face, a: v} }
+	for i, f := range b.faces {
+		loop := f.Loop()
+		keys := make([]conwayKey, len(loop))
+		for j, v := range loop {
+			keys[j] = corner(i, v)
+		}
+		b.face(keys...)
+	}
+	err = b.forEachFan(func(v Vertex, faces []int, _ []Vertex) {
+		keys := make([]conwayKey, len(faces))
+		for j, f := range faces {
+			keys[j] = corner(f, v)
+		}
+		b.face(keys...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.forEachEdge(func(a, c Vertex, left, right int) {
+		b.face(corner(left, c), corner(left, a), corner(right, c))
+		b.face(corner(left, a), corner(right, a), corner(right, c))
+	})
+	return b.build()
+}
+
+// Join returns the dual of the rectification of the seed, which has a quadrilateral for every Edge of the seed.
+func Join(seed Interface) (*Polyhedron, error) {
+	return polarDual(Ambo(seed))
+}
+
+// Expand returns the cantellation of the seed, which separates the faces of the seed and a Face for every vertex of
+// the seed by a quadrilateral along every Edge. It is the rectification of the rectification.
+func Expand(seed Interface) (*Polyhedron, error) {
+	a, err := Ambo(seed)
+	if err != nil {
+		return nil, err
+	}
+	return Ambo(a)
+}
+
+// Bevel returns the truncation of the rectification of the seed, in which every n-gon of the seed becomes a 2n-gon
+// and every vertex and Edge of the seed is replaced by a Face.
+func Bevel(seed Interface) (*Polyhedron, error) {
+	a, err := Ambo(seed)
+	if err != nil {
+		return nil, err
+	}
+	return Truncate(a)
+}
+
+// Ortho returns the dual of the expansion of the seed, in which every n-gon of the seed is replaced by n
+// quadrilaterals.
+func Ortho(seed Interface) (*Polyhedron, error) {
+	return polarDual(Expand(seed))
+}
+
+// Meta returns the dual of the bevel of the seed, in which every n-gon of the seed is replaced by 2n triangles.
+func Meta(seed Interface) (*Polyhedron, error) {
+	return polarDual(Bevel(seed))
+}
+
+// Gyro returns the dual of the snub of the seed, in which every n-gon of the seed is replaced by n pentagons.
+func Gyro(seed Interface) (*Polyhedron, error) {
+	return polarDual(Snub(seed))
+}
@@ -0,0 +1,94 @@
+package polyhedra
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConwayOperatorCounts(t *testing.T) {
+	tests := []struct {
+		name                   string
+		operator               func(Interface) (*Polyhedron, error)
+		vertices, edges, faces int
+	}{
+		{"dual", func(p Interface) (*Polyhedron, error) { return Dual(p) }, 6, 12, 8},
+		{"kis", Kis, 14, 36, 24},
+		{"truncate", Truncate, 24, 36, 14},
+		{"ambo", Ambo, 12, 24, 14},
+		{"join", Join, 14, 24, 12},
+		{"expand", Expand, 24, 48, 26},
+		{"bevel", Bevel, 48, 72, 26},
+		{"snub", Snub, 24, 60, 38},
+		{"gyro", Gyro, 38, 60, 24},
+		{"ortho", Ortho, 26, 48, 24},
+		{"meta", Meta, 26, 72, 48},
+		{"chamfer", Chamfer, 32, 48, 18},
+	}
+	for _, test := range tests {
+		p, err := test.operator(NewCube(1))
+		if err != nil {
+			t.Errorf("%v: %v", test.name, err)
+			continue
+		}
+		if len(p.Vertices()) != test.vertices || len(p.Edges()) != test.edges || len(p.Faces()) != test.faces {
+			t.Errorf("%v has %v vertices, %v edges and %v faces, expected %v, %v and %v", test.name,
+				len(p.Vertices()), len(p.Edges()), len(p.Faces()), test.vertices, test.edges, test.faces)
+		}
+		if _, err := NewPolyhedron(p.Vertices(), p.Edges(), p.Faces()); err != nil {
+			t.Errorf("%v is not valid: %v", test.name, err)
+		}
+		assertOutwardWinding(p, t)
+		if issues := NewIntegrityChecker().CheckIntegrity(p); len(issues) != 0 {
+			t.Errorf("%v has integrity issues: %v", test.name, issues)
+		}
+	}
+}
+
+func TestConwayOperatorsOnSeeds(t *testing.T) {
+	dual := func(p Interface) (*Polyhedron, error) { return Dual(p) }
+	operators := []func(Interface) (*Polyhedron, error){dual, Kis, Truncate, Ambo, Join, Expand, Bevel, Snub, Gyro,
+		Ortho, Meta, Chamfer}
+	// The faces of the results on the irregular seeds are not planar, which the integrity checker only warns about.
+	seeds := []*Polyhedron{NewTetrahedron(1), NewDodecahedron(1), newPrism(5), newPyramid(4), johnsonSolid(37, t),
+		johnsonSolid(84, t)}
+	for _, seed := range seeds {
+		for i, operator := range operators {
+			p, err := operator(seed)
+			if err != nil {
+				t.Errorf("Operator %v failed on a seed with %v faces: %v", i, len(seed.Faces()), err)
+				continue
+			}
+			if euler := len(p.Vertices()) - len(p.Edges()) + len(p.Faces()); euler != 2 {
+				t.Errorf("Operator %v produced a result with Euler characteristic %v", i, euler)
+			}
+			assertOutwardWinding(p, t)
+			assertNoIntegrityErrors(p, t)
+		}
+	}
+}
+
+func TestAmboOfCubeIsCuboctahedron(t *testing.T) {
+	p, err := Ambo(NewCube(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCongruent(p, NewCuboctahedron(math.Sqrt(2.0/3)), t)
+}
+
+func TestChamferOfDodecahedronIsGoldbergPolyhedron(t *testing.T) {
+	p, err := Chamfer(NewDodecahedron(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := NewIcosahedralGoldbergPolyhedron(2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Vertices()) != len(g.Vertices()) || len(p.Faces()) != len(g.Faces()) {
+		t.Errorf("Chamfered dodecahedron has %v vertices and %v faces, expected %v and %v", len(p.Vertices()),
+			len(p.Faces()), len(g.Vertices()), len(g.Faces()))
+	}
+	if issues := NewIntegrityChecker().CheckIntegrity(p); len(issues) != 0 {
+		t.Errorf("Chamfered dodecahedron has integrity issues: %v", issues)
+	}
+}
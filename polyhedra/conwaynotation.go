@@ -0,0 +1,182 @@
+package polyhedra
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// ConwayNotation is a parsed Conway notation string such as "tkD", which describes a Polyhedron as a seed with a
+// sequence of Conway operators applied to it from right to left.
+//
+// The seeds are T, C, O, D and I for the Platonic solids with circumradius 1, as well as Pn, An and Yn for the prism,
+// antiprism and pyramid over a regular n-gon. The operators are d, k, t, a, j, e, b, s, g, o, m and c, see Dual, Kis,
+// Truncate, Ambo, Join, Expand, Bevel, Snub, Gyro, Ortho, Meta and Chamfer. The dual is taken by polar reciprocation.
+// A number after k restricts it to the faces with that many sides, a number after t to the vertices with that degree.
+// Numbers are at most 1000.
+type ConwayNotation struct {
+	notation   string
+	seed       func() (*Polyhedron, error)
+	operations []func(Interface) (*Polyhedron, error)
+}
+
+// ConwayNotationError is returned for a malformed Conway notation string. Position is the byte offset of the problem
+// in the string.
+type ConwayNotationError struct {
+	Notation string
+	Position int
+	Message  string
+}
+
+func (e *ConwayNotationError) Error() string {
+	return fmt.Sprintf("invalid Conway notation %q at position %v: %v", e.Notation, e.Position, e.Message)
+}
+
+// conwayMaxNumber is the largest number that is accepted after a seed or an operator. It keeps malformed input from
+// creating huge polyhedra.
+const conwayMaxNumber = 1000
+
+// conwaySeeds are the seeds that take no number.
+var conwaySeeds = map[byte]func() (*Polyhedron, error){
+	'T': func() (*Polyhedron, error) { return NewTetrahedron(1), nil },
+	'C': func() (*Polyhedron, error) { return NewCube(1), nil },
+	'O': func() (*Polyhedron, error) { return NewOctahedron(1), nil },
+	'D': func() (*Polyhedron, error) { return NewDodecahedron(1), nil },
+	'I': func() (*Polyhedron, error) { return NewIcosahedron(), nil },
+}
+
+// conwayFamilies are the seeds that need the number of sides of their polygons.
+var conwayFamilies = map[byte]func(n int) (*Polyhedron, error){
+	'P': NewPrism,
+	'A': NewAntiprism,
+	'Y': NewPyramid,
+}
+
+// conwayOperators are the operators by their letters.
+var conwayOperators = map[byte]func(Interface) (*Polyhedron, error){
+	'd': func(p Interface) (*Polyhedron, error) { return Dual(p, WithPlacement(PolarPlacement)) },
+	'k': Kis,
+	't': Truncate,
+	'a': Ambo,
+	'j': Join,
+	'e': Expand,
+	'b': Bevel,
+	's': Snub,
+	'g': Gyro,
+	'o': Ortho,
+	'm': Meta,
+	'c': Chamfer,
+}
+
+// conwayRestrictedOperators are the operators that accept a number, by their letters.
+var conwayRestrictedOperators = map[byte]func(Interface, int) (*Polyhedron, error){
+	'k': kis,
+	't': truncate,
+}
+
+// ParseConway parses the given Conway notation string. It returns a *ConwayNotationError if the string is malformed.
+func ParseConway(notation string) (*ConwayNotation, error) {
+	c := &ConwayNotation{notation: notation}
+	fail := func(position int, format string, a ...interface{}) (*ConwayNotation, error) {
+		return nil, &ConwayNotationError{notation, position, fmt.Sprintf(format, a...)}
+	}
+	// number reads the number that starts at position i, if any, and returns it together with the position after it.
+	// Numbers that do not fit into an int are returned as conwayMaxNumber+1.
+	number := func(i int) (int, int, bool) {
+		end := i
+		for end < len(notation) && notation[end] >= '0' && notation[end] <= '9' {
+			end++
+		}
+		if end == i {
+			return 0, i, false
+		}
+		n, err := strconv.Atoi(notation[i:end])
+		if err != nil {
+			n = conwayMaxNumber + 1
+		}
+		return n, end, true
+	}
+
+	for i := 0; i < len(notation); {
+		letter := notation[i]
+		if seed, ok := conwaySeeds[letter]; ok {
+			if _, _, ok := number(i + 1); ok {
+				return fail(i+1, "seed %q takes no number", letter)
+			}
+			c.seed = seed
+			i++
+		} else if family, ok := conwayFamilies[letter]; ok {
+			n, end, ok := number(i + 1)
+			if !ok {
+				return fail(i+1, "seed %q needs the number of sides", letter)
+			}
+			if n > conwayMaxNumber {
+				return fail(i+1, "seed %q takes at most %v sides, got %v", letter, conwayMaxNumber, notation[i+1:end])
+			}
+			if err := checkPolygonSides(n); err != nil {
+				return fail(i+1, "seed %q: %v", letter, err)
+			}
+			c.seed = func() (*Polyhedron, error) { return family(n) }
+			i = end
+		} else if operator, ok := conwayOperators[letter]; ok {
+			n, end, ok := number(i + 1)
+			if ok {
+				restricted, restrictable := conwayRestrictedOperators[letter]
+				if !restrictable {
+					return fail(i+1, "operator %q takes no number", letter)
+				}
+				if n < 3 {
+					return fail(i+1, "operator %q needs a number of at least 3, got %v", letter, notation[i+1:end])
+				}
+				if n > conwayMaxNumber {
+					return fail(i+1, "operator %q takes a number of at most %v, got %v", letter, conwayMaxNumber,
+						notation[i+1:end])
+				}
+				operator = func(p Interface) (*Polyhedron, error) { return restricted(p, n) }
+			}
+			c.operations = append(c.operations, operator)
+			i = end
+		} else {
+			r, _ := utf8.DecodeRuneInString(notation[i:])
+			return fail(i, "unknown symbol %q", r)
+		}
+
+		if c.seed != nil && i < len(notation) {
+			r, _ := utf8.DecodeRuneInString(notation[i:])
+			return fail(i, "unexpected %q after the seed", r)
+		}
+	}
+	if c.seed == nil {
+		return fail(len(notation), "missing seed")
+	}
+	return c, nil
+}
+
+// String returns the notation as it was parsed.
+func (c *ConwayNotation) String() string {
+	return c.notation
+}
+
+// Polyhedron creates the seed and applies the operators to it.
+func (c *ConwayNotation) Polyhedron() (*Polyhedron, error) {
+	p, err := c.seed()
+	if err != nil {
+		return nil, err
+	}
+	for i := len(c.operations) - 1; i >= 0; i-- {
+		p, err = c.operations[i](p)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// FromConway creates the Polyhedron that is described by the given Conway notation string, see ConwayNotation.
+func FromConway(notation string) (*Polyhedron, error) {
+	c, err := ParseConway(notation)
+	if err != nil {
+		return nil, err
+	}
+	return c.Polyhedron()
+}
@@ -0,0 +1,91 @@
+package polyhedra
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFromConway(t *testing.T) {
+	tests := []struct {
+		notation               string
+		vertices, edges, faces int
+	}{
+		{"T", 4, 6, 4},
+		{"I", 12, 30, 20},
+		{"dC", 6, 12, 8},
+		{"tI", 60, 90, 32},
+		{"tkD", 180, 270, 92},
+		{"dk5tI", 80, 150, 72},
+		{"k5tI", 72, 150, 80},
+		{"t3D", 60, 90, 32},
+		{"aP5", 15, 30, 17},
+		{"sA4", 32, 80, 50},
+		{"cY3", 16, 24, 10},
+		{"gO", 38, 60, 24},
+	}
+	for _, test := range tests {
+		p, err := FromConway(test.notation)
+		if err != nil {
+			t.Errorf("%v: %v", test.notation, err)
+			continue
+		}
+		if len(p.Vertices()) != test.vertices || len(p.Edges()) != test.edges || len(p.Faces()) != test.faces {
+			t.Errorf("%v has %v vertices, %v edges and %v faces, expected %v, %v and %v", test.notation,
+				len(p.Vertices()), len(p.Edges()), len(p.Faces()), test.vertices, test.edges, test.faces)
+		}
+	}
+}
+
+func TestParseConwayErrors(t *testing.T) {
+	tests := []struct {
+		notation string
+		position int
+	}{
+		{"", 0},
+		{"tk", 2},
+		{"txI", 1},
+		{"tIk", 2},
+		{"P", 1},
+		{"aP2", 2},
+		{"I5", 1},
+		{"a3C", 1},
+		{"k2C", 1},
+		{"CC", 1},
+		{"P1001", 1},
+		{"A99999999999999999999", 1},
+		{"Y1001", 1},
+		{"k1001C", 1},
+		{"t99999999999999999999C", 1},
+		{"aé", 1},
+		{"Cé", 1},
+	}
+	for _, test := range tests {
+		_, err := ParseConway(test.notation)
+		notationErr, ok := err.(*ConwayNotationError)
+		if !ok {
+			t.Errorf("Expected a ConwayNotationError for %q, got %v", test.notation, err)
+			continue
+		}
+		if notationErr.Position != test.position {
+			t.Errorf("Error for %q is at position %v, expected %v: %v", test.notation, notationErr.Position,
+				test.position, err)
+		}
+	}
+}
+
+func TestParseConwayReportsCharacters(t *testing.T) {
+	for _, notation := range []string{"é", "tCé"} {
+		_, err := FromConway(notation)
+		if err == nil || !strings.Contains(err.Error(), "'é'") {
+			t.Errorf("Expected the error for %q to report 'é', got %v", notation, err)
+		}
+	}
+}
+
+func TestParseConwayMaxNumber(t *testing.T) {
+	for _, notation := range []string{"P1000", "k1000C"} {
+		if _, err := ParseConway(notation); err != nil {
+			t.Errorf("Expected %q to be accepted, got %v", notation, err)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package polyhedra
+
+import (
+	"fmt"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// placementKind enumerates the ways of placing the vertices of a dual.
+type placementKind int
+
+const (
+	centroidPlacement placementKind = iota
+	polarPlacement
+	circumcentrePlacement
+)
+
+// Placement determines where Dual places the vertex that replaces a Face.
+type Placement struct {
+	kind   placementKind
+	radius float64
+}
+
+// CentroidPlacement places each vertex of the dual at the centroid of the vertices of its Face. The faces of the dual
+// are only planar if the vertex figures of the original are, use PolarPlacement to get planar faces.
+var CentroidPlacement = Placement{kind: centroidPlacement}
+
+// PolarPlacement places the vertices of the dual by polar reciprocation about a sphere around the origin. The vertex
+// of a Face is the pole of the plane of the Face. The radius of the sphere is the mean distance of the edge midpoints
+// from the origin, which keeps the midsphere of polyhedra that have one.
+var PolarPlacement = Placement{kind: polarPlacement}
+
+// PolarPlacementWithRadius places the vertices of the dual by polar reciprocation about the sphere with the given
+// radius around the origin.
+func PolarPlacementWithRadius(radius float64) Placement {
+	return Placement{kind: polarPlacement, radius: radius}
+}
+
+// CircumcentrePlacement places each vertex of the dual at the centre of a circle through three vertices of its Face
+// that do not lie on a line, which is the circumcentre of faces that have one.
+var CircumcentrePlacement = Placement{kind: circumcentrePlacement}
+
+// DualOption configures optional aspects of Dual.
+type DualOption func(*dualConfig)
+
+// dualConfig collects the options of Dual.
+type dualConfig struct {
+	placement Placement
+}
+
+// WithPlacement selects where the vertices of the dual are placed. The default is CentroidPlacement.
+func WithPlacement(p Placement) DualOption {
+	return func(c *dualConfig) {
+		c.placement = p
+	}
+}
+
+// PolarReciprocationError is returned when the plane of a Face passes through the centre of reciprocation, so that
+// its pole lies at infinity.
+type PolarReciprocationError struct {
+	Face Face
+}
+
+func (e *PolarReciprocationError) Error() string {
+	return fmt.Sprintf("plane of face %v passes through the centre of reciprocation", e.Face.String())
+}
+
+// Dual returns the dual of the given Polyhedron, which has a vertex for every Face, a Face for every vertex and an
+// Edge for every Edge of the original. The i-th vertex of the dual replaces the i-th Face and the i-th Face of the dual
+// replaces the i-th vertex of the original. Faces of the dual are wound in the same sense as the faces of the
+// original.
+//
+// The Polyhedron needs to be a closed manifold with consistently wound faces. Otherwise one of the errors of
+// NewPolyhedron, *InconsistentWindingError or *NonManifoldVertexError is returned. With PolarPlacement a
+// *PolarReciprocationError is returned if the plane of a Face passes through the origin, with CircumcentrePlacement a
+// *DegenerateFaceError is returned if all vertices of a Face lie on a line.
+func Dual(p Interface, options ...DualOption) (*Polyhedron, error) {
+	config := dualConfig{placement: CentroidPlacement}
+	for _, option := range options {
+		option(&config)
+	}
+	d, err := dual(p, config)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// directedEdge is an Edge that is traversed from the first to the second vertex.
+type directedEdge [2]Vertex
+
+// orientedFaces gives access to the faces of a closed manifold with consistently wound faces by their directed edges.
+type orientedFaces struct {
+	p         Interface
+	faces     []Face
+	faceOf    map[directedEdge]int
+	faceCount map[Vertex]int
+}
+
+// newOrientedFaces validates the given Polyhedron and indexes its faces by their directed edges.
+func newOrientedFaces(p Interface) (orientedFaces, error) {
+	faces := p.Faces()
+	if err := validate(p.Vertices(), p.Edges(), faces); err != nil {
+		return orientedFaces{}, err
+	}
+
+	// Every directed edge belongs to exactly one Face if the faces are wound consistently.
+	o := orientedFaces{p, faces, make(map[directedEdge]int), make(map[Vertex]int)}
+	for i, f := range faces {
+		loop := f.Loop()
+		for j, v := range loop {
+			de := directedEdge{v, loop[(j+1)%len(loop)]}
+			if _, ok := o.faceOf[de]; ok {
+				return orientedFaces{}, &InconsistentWindingError{NewEdge(de[0], de[1])}
+			}
+			o.faceOf[de] = i
+			o.faceCount[v]++
+		}
+	}
+	return o, nil
+}
+
+// fan returns the indices of the faces around the vertex in the same rotational sense as the loops of the faces,
+// together with the neighbour that follows the vertex in each of these faces.
+func (o orientedFaces) fan(v Vertex) ([]int, []Vertex, error) {
+	start := -1
+	for _, w := range o.p.AdjacentVertices(v) {
+		if i, ok := o.faceOf[directedEdge{v, w}]; ok {
+			start = i
+			break
+		}
+	}
+	if start == -1 {
+		return nil, nil, &NonManifoldVertexError{v}
+	}
+	faces := make([]int, 0, o.faceCount[v])
+	neighbours := make([]Vertex, 0, o.faceCount[v])
+	// Stepping from a Face to the one that follows the Edge into the vertex walks around the vertex.
+	for i := start; ; {
+		loop := o.faces[i].Loop()
+		for j, w := range loop {
+			if w == v {
+				faces = append(faces, i)
+				neighbours = append(neighbours, loop[(j+1)%len(loop)])
+				i = o.faceOf[directedEdge{v, loop[(j+len(loop)-1)%len(loop)]}]
+				break
+			}
+		}
+		if i == start {
+			break
+		}
+		if len(faces) > o.faceCount[v] {
+			return nil, nil, &NonManifoldVertexError{v}
+		}
+	}
+	if len(faces) != o.faceCount[v] {
+		return nil, nil, &NonManifoldVertexError{v}
+	}
+	return faces, neighbours, nil
+}
+
+// dual computes the dual of the given Polyhedron.
+func dual(p Interface, config dualConfig) (Polyhedron, error) {
+	o, err := newOrientedFaces(p)
+	if err != nil {
+		return Polyhedron{}, err
+	}
+
+	d := Polyhedron{}
+	d.init()
+	radius := config.placement.radius
+	if config.placement.kind == polarPlacement && radius == 0 {
+		radius = meanMidradius(p)
+	}
+	dualVertices := make([]Vertex, len(o.faces))
+	for i, f := range o.faces {
+		pos, err := dualVertexPosition(p, f, config.placement.kind, radius)
+		if err != nil {
+			return Polyhedron{}, err
+		}
+		dualVertices[i] = d.newVertex(pos)
+	}
+
+	for _, v := range p.Vertices() {
+		faces, _, err := o.fan(v)
+		if err != nil {
+			return Polyhedron{}, err
+		}
+		loop := make([]Vertex, len(faces))
+		for i, f := range faces {
+			loop[i] = dualVertices[f]
+		}
+		d.addFace(NewFace(loop))
+	}
+
+	edges := make([]Edge, 0, len(p.Edges()))
+	for _, e := range p.Edges() {
+		a, b := e.Vertices()[0], e.Vertices()[1]
+		edges = append(edges, NewEdge(dualVertices[o.faceOf[directedEdge{a, b}]], dualVertices[o.faceOf[directedEdge{b, a}]]))
+	}
+	d.setEdges(edges)
+	return d, nil
+}
+
+// dualVertexPosition returns the position of the vertex that replaces the given Face in the dual.
+func dualVertexPosition(p Interface, f Face, kind placementKind, radius float64) (r3.Point, error) {
+	loop := f.Loop()
+	positions := make([]r3.Point, len(loop))
+	for i, v := range loop {
+		positions[i] = p.VertexPosition(v)
+	}
+	switch kind {
+	case polarPlacement:
+		normal := faceNormal(p, f)
+		distance := normal.Dot(origin.VectorTo(r3.Centroid3D(positions)))
+		if distance*distance < 1e-18*radius*radius {
+			return r3.Point{}, &PolarReciprocationError{f}
+		}
+		return origin.Add(normal.Scale(radius * radius / distance)), nil
+	case circumcentrePlacement:
+		c, ok := circumcentre(positions)
+		if !ok {
+			return r3.Point{}, &DegenerateFaceError{f}
+		}
+		return c, nil
+	}
+	return r3.Centroid3D(positions), nil
+}
+
+// circumcentre returns the centre of the circle through the first point and the two other points that span the
+// largest triangle with it. It returns false if all points lie on a line.
+func circumcentre(points []r3.Point) (r3.Point, bool) {
+	a := points[0]
+	var ab, ac, n r3.Vector
+	for i := 1; i < len(points); i++ {
+		for j := i + 1; j < len(points); j++ {
+			u, v := a.VectorTo(points[i]), a.VectorTo(points[j])
+			if cross := u.Cross(v); cross.Length() > n.Length() {
+				ab, ac, n = u, v, cross
+			}
+		}
+	}
+	if n.Length() <= 1e-9*ab.Length()*ac.Length() {
+		return r3.Point{}, false
+	}
+	offset := n.Cross(ab).Scale(ac.Dot(ac)).Add(ac.Cross(n).Scale(ab.Dot(ab))).Scale(1 / (2 * n.Dot(n)))
+	return a.Add(offset), true
+}
+
+// meanMidradius returns the mean distance of the edge midpoints of the Polyhedron from the origin.
+func meanMidradius(p Interface) float64 {
+	sum := 0.0
+	for _, e := range p.Edges() {
+		vs := e.Vertices()
+		mid := r3.Centroid3D([]r3.Point{p.VertexPosition(vs[0]), p.VertexPosition(vs[1])})
+		sum += r3.Distance(origin, mid)
+	}
+	return sum / float64(len(p.Edges()))
+}
@@ -0,0 +1,141 @@
+package polyhedra
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+func TestDualOfPlatonicSolids(t *testing.T) {
+	cube, err := Dual(NewOctahedron(1), WithPlacement(PolarPlacementWithRadius(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCongruent(cube, NewCube(math.Sqrt(3)), t)
+	assertOutwardWinding(cube, t)
+
+	octahedron, err := Dual(NewCube(1), WithPlacement(PolarPlacementWithRadius(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCongruent(octahedron, NewOctahedron(math.Sqrt(3)), t)
+	assertOutwardWinding(octahedron, t)
+
+	// The dodecahedron has circumradius 1, the centroids of its faces lie on its insphere.
+	dodecahedron := NewDodecahedron(1)
+	inradius := 0.0
+	for _, f := range dodecahedron.Faces() {
+		inradius += origin.VectorTo(dodecahedron.FaceCenter(f)).Length() / float64(len(dodecahedron.Faces()))
+	}
+	icosahedron, err := Dual(dodecahedron)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCongruent(icosahedron, NewIcosahedronWithRadius(inradius), t)
+	assertOutwardWinding(icosahedron, t)
+
+	tetrahedron, err := Dual(NewTetrahedron(1), WithPlacement(CircumcentrePlacement))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCongruent(tetrahedron, NewTetrahedron(1.0/3), t)
+}
+
+func TestDualStructure(t *testing.T) {
+	for _, p := range []*Polyhedron{NewTruncatedIcosahedron(1), NewSnubCube(1), newPrism(7), johnsonSolid(27, t)} {
+		d, err := Dual(p, WithPlacement(PolarPlacementWithRadius(1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(d.Vertices()) != len(p.Faces()) || len(d.Faces()) != len(p.Vertices()) ||
+			len(d.Edges()) != len(p.Edges()) {
+			t.Errorf("Dual has %v vertices, %v edges and %v faces, expected %v, %v and %v", len(d.Vertices()),
+				len(d.Edges()), len(d.Faces()), len(p.Faces()), len(p.Edges()), len(p.Vertices()))
+		}
+		for i, v := range p.Vertices() {
+			if len(d.Faces()[i].Loop()) != p.VertexDegree(v) {
+				t.Errorf("Face %v of the dual has %v sides, expected %v", i, len(d.Faces()[i].Loop()), p.VertexDegree(v))
+			}
+		}
+		if issues := NewIntegrityChecker().CheckIntegrity(d); len(issues) != 0 {
+			t.Errorf("Dual has integrity issues: %v", issues)
+		}
+		dd, err := Dual(d, WithPlacement(PolarPlacementWithRadius(1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertCongruent(dd, p, t)
+	}
+}
+
+func TestDualRejectsInconsistentWinding(t *testing.T) {
+	p := NewCube(1)
+	faces := p.Faces()
+	loop := faces[0].Loop()
+	for i, j := 0, len(loop)-1; i < j; i, j = i+1, j-1 {
+		loop[i], loop[j] = loop[j], loop[i]
+	}
+	faces[0] = NewFace(loop)
+	flipped, err := NewPolyhedron(p.Vertices(), p.Edges(), faces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Dual(flipped)
+	if _, ok := err.(*InconsistentWindingError); !ok {
+		t.Errorf("Expected an InconsistentWindingError, got %v", err)
+	}
+}
+
+func TestDualCircumcentreOfCollinearVertices(t *testing.T) {
+	// A square pyramid with an additional vertex in the middle of a base edge, which makes the first three vertices of
+	// two faces collinear.
+	positions := []r3.Point{{1, -1, -1}, {1, 1, -1}, {-1, 1, -1}, {-1, -1, -1}, {0, 0, 1}, {1, 0, -1}}
+	p := newPolyhedronFromLoops(positions, [][]int{{1, 5, 0, 3, 2}, {0, 5, 1, 4}, {1, 2, 4}, {2, 3, 4}, {3, 0, 4}})
+	d, err := Dual(&p, WithPlacement(CircumcentrePlacement))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range d.Vertices() {
+		pos := d.VertexPosition(v)
+		if math.IsNaN(pos.X) || math.IsNaN(pos.Y) || math.IsNaN(pos.Z) {
+			t.Errorf("Vertex %v of the dual is at %v", v, pos)
+		}
+	}
+	// The side with the additional vertex is replaced by the centre of the circle through its corners and the apex.
+	side := d.VertexPosition(d.Vertices()[1])
+	if r1, r2 := r3.Distance(side, positions[4]), r3.Distance(side, positions[0]); math.Abs(r1-r2) > 1e-9 {
+		t.Errorf("Circumcentre %v is not equidistant from the apex and a corner: %v and %v", side, r1, r2)
+	}
+
+	// A Face whose vertices all lie on a line has no circumcentre.
+	q := newPolyhedronFromLoops(positions, [][]int{{0, 3, 2, 1}, {0, 1, 5}, {0, 5, 1, 4}, {1, 2, 4}, {2, 3, 4}, {3, 0, 4}})
+	_, err = Dual(&q, WithPlacement(CircumcentrePlacement))
+	if _, ok := err.(*DegenerateFaceError); !ok {
+		t.Errorf("Expected a DegenerateFaceError, got %v", err)
+	}
+}
+
+func TestDualRejectsUnusedVertex(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	p := unvalidatedPolyhedron(append(vertices, 5), edges, faces)
+	_, err := Dual(p)
+	if _, ok := err.(*UnusedVertexError); !ok {
+		t.Errorf("Expected an UnusedVertexError, got %v", err)
+	}
+	_, err = Ambo(p)
+	if _, ok := err.(*UnusedVertexError); !ok {
+		t.Errorf("Expected an UnusedVertexError from Ambo, got %v", err)
+	}
+}
+
+func TestFanOfVertexWithoutFaces(t *testing.T) {
+	o, err := newOrientedFaces(NewTetrahedron(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = o.fan(Vertex(99))
+	if _, ok := err.(*NonManifoldVertexError); !ok {
+		t.Errorf("Expected a NonManifoldVertexError, got %v", err)
+	}
+}
@@ -19,14 +19,14 @@ type Edge struct {
 	v1, v2 Vertex
 }
 
-// Length returns the length of the Edge, defined as the distance between the two end vertices.
-func (e Edge) Length() float64 {
-	return r3.Distance(e.v1.Position(), e.v2.Position())
+// EdgeLength returns the length of the Edge, defined as the distance between the two end vertices.
+func (p *Polyhedron) EdgeLength(e Edge) float64 {
+	return r3.Distance(p.positions[e.v1], p.positions[e.v2])
 }
 
-// Center returns the midpoint of the Edge.
-func (e Edge) Center() r3.Point {
-	return r3.Centroid3D([]r3.Point{e.v1.Position(), e.v2.Position()})
+// EdgeCenter returns the midpoint of the Edge.
+func (p *Polyhedron) EdgeCenter(e Edge) r3.Point {
+	return r3.Centroid3D([]r3.Point{p.positions[e.v1], p.positions[e.v2]})
 }
 
 // Contains check whether either end point equals the given Vertex v.
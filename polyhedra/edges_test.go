@@ -3,7 +3,7 @@ package polyhedra
 import "testing"
 
 func TestEdgeReversal(t *testing.T) {
-	e1 := Edge{NewVertex(), NewVertex()}
+	e1 := Edge{1, 2}
 	e2 := Edge{e1.v2, e1.v1}
 
 	if e1.Reversed() != e2 {
@@ -0,0 +1,47 @@
+package polyhedra
+
+import (
+	"fmt"
+)
+
+// The writers for mesh file formats refer to vertices by dense indices, while the vertices of a Polyhedron are
+// identified by IDs that need not be contiguous. They buffer their output in a bufio.Writer, which keeps the first
+// error of the underlying writer and reports it from Flush.
+
+// vertexIndices returns the index of every vertex of the Polyhedron in the order of Vertices, starting at 0.
+func vertexIndices(p Interface) map[Vertex]int {
+	vertices := p.Vertices()
+	indices := make(map[Vertex]int, len(vertices))
+	for i, v := range vertices {
+		indices[v] = i
+	}
+	return indices
+}
+
+// Encoding selects between the text and the binary variant of a file format.
+type Encoding int
+
+const (
+	// ASCIIEncoding writes the human readable text variant of the file format.
+	ASCIIEncoding Encoding = iota
+	// BinaryEncoding writes the binary variant of the file format with little-endian numbers.
+	BinaryEncoding
+)
+
+// checkEncoding returns an error if the Encoding is none of the defined encodings.
+func checkEncoding(encoding Encoding) error {
+	if encoding != ASCIIEncoding && encoding != BinaryEncoding {
+		return fmt.Errorf("unknown encoding %v", int(encoding))
+	}
+	return nil
+}
+
+// fanTriangles returns the triangles that fan out from the first vertex of the Face.
+func fanTriangles(f Face) [][3]Vertex {
+	loop := f.Loop()
+	triangles := make([][3]Vertex, 0, len(loop)-2)
+	for i := 1; i+1 < len(loop); i++ {
+		triangles = append(triangles, [3]Vertex{loop[0], loop[i], loop[i+1]})
+	}
+	return triangles
+}
@@ -2,6 +2,7 @@ package polyhedra
 
 import (
 	"fmt"
+
 	"github.com/MichaelMauderer/polyhedra/r3"
 )
 
@@ -10,7 +11,6 @@ func NewFace(loop []Vertex) Face {
 	f := Face{}
 	f.loop = normaliseLoop(loop)
 	f.initEdges()
-	f.initCenter()
 	return f
 }
 
@@ -24,9 +24,8 @@ func normaliseLoop(loop []Vertex) []Vertex {
 
 // Face represents a face on a polyhedron.
 type Face struct {
-	loop   []Vertex
-	edges  []Edge
-	center r3.Point
+	loop  []Vertex
+	edges []Edge
 }
 
 // initEdges computes the edges between all consecutive vertices in the given list, as well as the last and first one.
@@ -40,9 +39,12 @@ func (f *Face) initEdges() {
 	}
 }
 
-// initCenter precomputes the geometric center of all vertices and stores it in Face.center.
-func (f *Face) initCenter() {
-	f.center = vertexCentroid(f.loop)
+// clone returns a copy of the Face that shares no memory with the original.
+func (f *Face) clone() Face {
+	return Face{
+		loop:  append([]Vertex(nil), f.loop...),
+		edges: append([]Edge(nil), f.edges...),
+	}
 }
 
 // Loop returns the list of vertices that make up the Face.
@@ -55,9 +57,9 @@ func (f *Face) Edges() []Edge {
 	return f.edges
 }
 
-// Center returns the geometric mean of all vertices that make up this Face.
-func (f *Face) Center() r3.Point {
-	return f.center
+// FaceCenter returns the geometric mean of all vertices that make up the Face.
+func (p *Polyhedron) FaceCenter(f Face) r3.Point {
+	return p.vertexCentroid(f.loop)
 }
 
 // Equals checks whether two faces are the same.
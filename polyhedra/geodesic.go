@@ -10,42 +10,77 @@ import (
 type Geodesic struct {
 	Polyhedron
 	m, n int
+	base baseSolid
+	// projection is the projection of the last subdivision.
+	projection Projection
 }
 
+// baseSolid describes the solid with triangular faces that a geodesic is created from.
+type baseSolid struct {
+	faces, edges, vertices int
+	vertexDegree           int
+}
+
+var (
+	tetrahedronBase = baseSolid{faces: 4, edges: 6, vertices: 4, vertexDegree: 3}
+	octahedronBase  = baseSolid{faces: 8, edges: 12, vertices: 6, vertexDegree: 4}
+	icosahedronBase = baseSolid{faces: 20, edges: 30, vertices: 12, vertexDegree: 5}
+)
+
 // IcosahedralGeodesic represents a geodesic Polyhedron with an icosahedron as a base.
 type IcosahedralGeodesic Geodesic
 
 // NewIcosahedralGeodesic creates a geodesic Polyhedron from an icosahedron through subdivision.
 func NewIcosahedralGeodesic() *Geodesic {
-	ico := newIcosahedron()
-	geo := Geodesic{ico, 1, 0}
+	ico := newIcosahedron(1)
+	geo := Geodesic{ico, 1, 0, icosahedronBase, PlanarProjection}
 	return &geo
 }
 
-func createVerticesForEdges(gg *Geodesic, m int, vertexToEdgeMap map[Edge]([]Vertex)) {
+// NewOctahedralGeodesic creates a geodesic Polyhedron from an octahedron through subdivision.
+func NewOctahedralGeodesic() *Geodesic {
+	geo := Geodesic{newOctahedron(1), 1, 0, octahedronBase, PlanarProjection}
+	return &geo
+}
+
+// NewTetrahedralGeodesic creates a geodesic Polyhedron from a tetrahedron through subdivision.
+func NewTetrahedralGeodesic() *Geodesic {
+	geo := Geodesic{newTetrahedron(1), 1, 0, tetrahedronBase, PlanarProjection}
+	return &geo
+}
+
+// Clone returns a deep copy of the geodesic. Modifying the copy, for example through Subdivide, does not affect the
+// original and vice versa.
+func (gg *Geodesic) Clone() *Geodesic {
+	return &Geodesic{gg.Polyhedron.clone(), gg.m, gg.n, gg.base, gg.projection}
+}
+
+// createVerticesForEdges creates the m-1 new vertices that divide each Edge of the geodesic into m equal parts.
+// The vertices for each Edge are stored in the vertexToEdgeMap ordered from the first to the second vertex of the Edge.
+func createVerticesForEdges(gg *Geodesic, m int, vertexToEdgeMap map[Edge]([]Vertex), config subdivisionConfig) {
 	for _, e := range gg.Edges() {
 		nV := make([]Vertex, m-1)
 		for j := range nV {
-			nV[j] = NewVertex()
 			ev := e.Vertices()
-			c := r3.WeightedCentroid(
+			c := config.position(
 				[]r3.Point{
-					vertexPositions[ev[0]],
-					vertexPositions[ev[1]],
+					gg.positions[ev[0]],
+					gg.positions[ev[1]],
 				},
 				[]float64{
+					float64(m - (j + 1)),
 					float64(j + 1),
-					float64(len(nV)),
 				},
 			)
-			vertexPositions[nV[j]] = c
-			gg.vertices = append(gg.vertices, nV[j])
+			nV[j] = gg.newVertex(c)
 		}
 		vertexToEdgeMap[e] = nV
 	}
 }
 
-func subdividedFace(face Face, gg *Geodesic, m int, newEdgeSet map[Edge]bool, vertexToEdgeMap map[Edge]([]Vertex)) (newEdges []Edge, newFaces []Face) {
+// subdividedFace splits the given triangular Face into m*m triangles, using the vertices that were already created for
+// its edges by createVerticesForEdges.
+func subdividedFace(face Face, gg *Geodesic, m int, newEdgeSet map[Edge]bool, vertexToEdgeMap map[Edge]([]Vertex), config subdivisionConfig) (newEdges []Edge, newFaces []Face) {
 
 	newFaces = make([]Face, 0)
 	newEdges = make([]Edge, 0)
@@ -54,10 +89,6 @@ func subdividedFace(face Face, gg *Geodesic, m int, newEdgeSet map[Edge]bool, ve
 	v1 := face.Loop()[1]
 	v2 := face.Loop()[2]
 
-	e0 := NewEdge(v0, v1)
-	e1 := NewEdge(v1, v2)
-	e2 := NewEdge(v2, v0)
-
 	// Create subdivision vertices
 	vertexRows := make([][]Vertex, m+1)
 	rowSize := 1
@@ -69,8 +100,10 @@ func subdividedFace(face Face, gg *Geodesic, m int, newEdgeSet map[Edge]bool, ve
 	// Create new interior Vertices
 	for row := 1; row < len(vertexRows)-1; row++ {
 		for j := 1; j < (len(vertexRows[row]) - 1); j++ {
-			vertexRows[row][j] = NewVertex()
-			gg.vertices = append(gg.vertices, vertexRows[row][j])
+			vertexRows[row][j] = gg.newVertex(config.position(
+				[]r3.Point{gg.positions[v0], gg.positions[v1], gg.positions[v2]},
+				[]float64{float64(m - row), float64(row - j), float64(j)},
+			))
 		}
 	}
 
@@ -79,21 +112,22 @@ func subdividedFace(face Face, gg *Geodesic, m int, newEdgeSet map[Edge]bool, ve
 	vertexRows[m][0] = v1
 	vertexRows[m][m] = v2
 
-	getReplacements := func(e Edge) []Vertex {
+	// getReplacements returns the vertices on the Edge between from and to, ordered from from to to.
+	getReplacements := func(from, to Vertex) []Vertex {
+		e := NewEdge(from, to)
 		rep := vertexToEdgeMap[e]
-		if rep == nil {
-			repReversed := vertexToEdgeMap[e.Reversed()]
-			rep = make([]Vertex, len(repReversed))
-			copy(rep, repReversed)
-			for i, j := 0, len(rep)-1; i < j; i, j = i+1, j-1 {
-				rep[i], rep[j] = rep[j], rep[i]
-			}
+		if e.v1 == from {
+			return rep
 		}
-		return rep
+		repReversed := make([]Vertex, len(rep))
+		for i := range rep {
+			repReversed[len(rep)-1-i] = rep[i]
+		}
+		return repReversed
 	}
-	rep0 := getReplacements(e0)
-	rep1 := getReplacements(e1)
-	rep2 := getReplacements(e2)
+	rep0 := getReplacements(v0, v1)
+	rep1 := getReplacements(v1, v2)
+	rep2 := getReplacements(v0, v2)
 
 	for i, iR := 1, 0; i <= (m - 1); i, iR = i+1, iR+1 {
 		// v0 -> v1
@@ -142,49 +176,103 @@ func subdividedFace(face Face, gg *Geodesic, m int, newEdgeSet map[Edge]bool, ve
 			nv1 := vertexRows[row][i+1]
 			nv2 := vertexRows[row+1][i+1]
 			// This creates duplicate edges (only needs to create faces)
-			connectNewFace(nv0, nv1, nv2)
+			connectNewFace(nv0, nv2, nv1)
 		}
 	}
 	return
 }
 
-// Subdivide applies the surface subdivision modifier to the geodesic using the given breakdown structure (n,m).
-// Currently only the breakdown structure (2,0) is supported.
-// For more information see https://en.wikibooks.org/wiki/Geodesic_Grids/Breakdown_structures
-func (gg *Geodesic) Subdivide(m, n int) error {
+// Handedness selects one of the two mirror-image triangulations of a Class III breakdown structure.
+type Handedness int
 
-	// TODO: implement Class I and II breakdowns.
-	if m == n {
-		return errors.New("Class II not supported")
-	}
-	if n != 0 {
-		return errors.New("Class III not supported")
+const (
+	// RightHanded places the corners of each Face on the lattice points (0,0), (m,n) and (-n,m+n).
+	RightHanded Handedness = iota
+	// LeftHanded produces the mirror image of RightHanded, which is the same as the breakdown structure (n,m).
+	LeftHanded
+)
+
+// subdivisionConfig holds the optional settings of a subdivision.
+type subdivisionConfig struct {
+	handedness Handedness
+	projection Projection
+	partition  PartitionMethod
+}
+
+// position returns the position of a new vertex with the given weights relative to the given points.
+func (c subdivisionConfig) position(points []r3.Point, weights []float64) r3.Point {
+	return c.projection.project(c.partition.point(points, weights))
+}
+
+// SubdivisionOption configures optional aspects of Geodesic.Subdivide.
+type SubdivisionOption func(*subdivisionConfig)
+
+// WithHandedness selects the handedness of a Class III subdivision. Class I and II subdivisions are not chiral and
+// ignore this option. The default is RightHanded.
+func WithHandedness(h Handedness) SubdivisionOption {
+	return func(c *subdivisionConfig) {
+		c.handedness = h
 	}
-	if m == 1 {
-		return nil
+}
+
+// composeBreakdowns returns the breakdown structure that is equivalent to applying (m2,n2) after (m1,n1).
+// Breakdown structures correspond to the Eisenstein integers m+n*w with w=exp(i*pi/3), so composing them is a
+// multiplication. The result is rotated by multiples of 60 degrees until m > 0 and n >= 0.
+func composeBreakdowns(m1, n1, m2, n2 int) (m, n int) {
+	m, n = m1*m2-n1*n2, m1*n2+n1*m2+n1*n2
+	for m <= 0 || n < 0 {
+		m, n = -n, m+n
 	}
-	if m != 2 {
-		return errors.New("only (m=2,n=0) subdivision supported")
+	return m, n
+}
+
+// Subdivide applies the surface subdivision modifier to the geodesic using the given breakdown structure (m,n) with
+// m >= 1 and n >= 0. This covers Class I (m,0), Class II (m,m) and Class III (m,n) breakdown structures.
+// By default new vertices are placed on the faces of the geodesic, WithProjection allows to place them on a sphere and
+// WithPartition selects how the faces are partitioned.
+// For more information see https://en.wikibooks.org/wiki/Geodesic_Grids/Breakdown_structures
+func (gg *Geodesic) Subdivide(m, n int, options ...SubdivisionOption) error {
+	config := subdivisionConfig{handedness: RightHanded, projection: PlanarProjection, partition: EqualChordPartition}
+	for _, option := range options {
+		option(&config)
 	}
 
-	t := m*m + m*n + n*n
-	newFaces := make([]Face, 0, 20*t)
-	newEdges := make([]Edge, 0)
-	newEdgeSet := make(map[Edge]bool)
+	if m < 1 || n < 0 {
+		return errors.New("breakdown requires m >= 1 and n >= 0")
+	}
+	if n != 0 && m != n && config.handedness == LeftHanded {
+		m, n = n, m
+	}
+	config.projection = config.projection.withBaseRadius(gg.meanRadius())
+	for _, v := range gg.vertices {
+		gg.positions[v] = config.projection.project(gg.positions[v])
+	}
 
-	vertexToEdgeMap := make(map[Edge]([]Vertex))
-	createVerticesForEdges(gg, m, vertexToEdgeMap)
+	if n == 0 {
+		newFaces := make([]Face, 0, len(gg.faces)*m*m)
+		newEdges := make([]Edge, 0)
+		newEdgeSet := make(map[Edge]bool)
+		vertexToEdgeMap := make(map[Edge]([]Vertex))
+		createVerticesForEdges(gg, m, vertexToEdgeMap, config)
 
-	for _, face := range gg.faces {
-		nE, nF := subdividedFace(face, gg, m, newEdgeSet, vertexToEdgeMap)
-		newFaces = append(newFaces, nF...)
-		newEdges = append(newEdges, nE...)
+		for _, face := range gg.faces {
+			nE, nF := subdividedFace(face, gg, m, newEdgeSet, vertexToEdgeMap, config)
+			newFaces = append(newFaces, nF...)
+			newEdges = append(newEdges, nE...)
+		}
+		gg.setEdges(newEdges)
+		gg.setFaces(newFaces)
+	} else {
+		// Class II and III breakdown structures are the Goldberg-Coxeter construction on the triangular lattice.
+		subdivided, err := goldbergCoxeter(&gg.Polyhedron, triangularLattice{}, m, n, config)
+		if err != nil {
+			return err
+		}
+		gg.Polyhedron = *subdivided
 	}
 
-	gg.setEdges(newEdges)
-	gg.setFaces(newFaces)
-	gg.m *= m
-	gg.n *= n
+	gg.m, gg.n = composeBreakdowns(gg.m, gg.n, m, n)
+	gg.projection = config.projection
 
 	return nil
 }
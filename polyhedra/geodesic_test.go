@@ -1,7 +1,10 @@
 package polyhedra
 
 import (
+	"math"
 	"testing"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
 )
 
 func TestGGSubdivision(t *testing.T) {
@@ -32,10 +35,18 @@ func TestGGSubdivision(t *testing.T) {
 		}
 	}
 	n := 0
-	for m := 2; m < 3; m++ {
+	for m := 1; m < 9; m++ {
 		t.Logf("Testing (m=%v,n=%v)", m, n)
 		checkSubdivision(m, n)
 	}
+	for m := 1; m < 5; m++ {
+		t.Logf("Testing (m=%v,n=%v)", m, m)
+		checkSubdivision(m, m)
+	}
+	for _, mn := range [][2]int{{2, 1}, {1, 2}, {3, 1}, {3, 2}, {4, 1}, {5, 2}} {
+		t.Logf("Testing (m=%v,n=%v)", mn[0], mn[1])
+		checkSubdivision(mn[0], mn[1])
+	}
 }
 
 func TestGGRepeatedSubdivision(t *testing.T) {
@@ -73,7 +84,7 @@ func TestGGEdgeOrder(t *testing.T) {
 		e1 := e1S[i]
 		e2 := e2S[i]
 
-		d := e1.Center().VectorTo(e2.Center()).Length()
+		d := gg1.EdgeCenter(e1).VectorTo(gg2.EdgeCenter(e2)).Length()
 		if d > epsilon {
 			t.Log(d)
 			t.Errorf("Expected egde order to be the same but vertex %v is %v and %v.", i, e1, e2)
@@ -81,3 +92,263 @@ func TestGGEdgeOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestGGSubdivisionInvalid(t *testing.T) {
+	gg := NewIcosahedralGeodesic()
+	if err := gg.Subdivide(0, 0); err == nil {
+		t.Error("Subdivision (m=0,n=0) should fail.")
+	}
+	if err := gg.Subdivide(-2, 0); err == nil {
+		t.Error("Subdivision (m=-2,n=0) should fail.")
+	}
+	if err := gg.Subdivide(1, -1); err == nil {
+		t.Error("Subdivision (m=1,n=-1) should fail.")
+	}
+}
+
+func TestGGRepeatedClassIISubdivision(t *testing.T) {
+	gg := NewIcosahedralGeodesic()
+	for i := 0; i < 2; i++ {
+		err := gg.Subdivide(1, 1)
+		if err != nil {
+			t.Fatalf("Legal subdivision failed: %v", err)
+		}
+		errs := IcosahedralGeodesicIntegrityChecker(*gg).CheckIntegrity()
+		if len(errs) != 0 {
+			t.Fatalf("Repeated subdivision (1,1) created illegal GG: %v", errs)
+		}
+	}
+	if len(gg.faces) != 20*9 {
+		t.Errorf("Number of faces is %v instead of %v.", len(gg.faces), 20*9)
+	}
+}
+
+func TestGGSubdivisionBreakdown(t *testing.T) {
+	checkBreakdown := func(steps [][2]int, handedness Handedness, m, n int) {
+		gg := NewIcosahedralGeodesic()
+		for _, step := range steps {
+			err := gg.Subdivide(step[0], step[1], WithHandedness(handedness))
+			if err != nil {
+				t.Fatalf("Legal subdivision failed: %v", err)
+			}
+		}
+		if gg.m != m || gg.n != n {
+			t.Errorf("Subdivisions %v resulted in breakdown (%v,%v) instead of (%v,%v)", steps, gg.m, gg.n, m, n)
+		}
+		T := m*m + m*n + n*n
+		if len(gg.faces) != 20*T {
+			t.Errorf("Subdivisions %v resulted in %v faces instead of %v", steps, len(gg.faces), 20*T)
+		}
+	}
+	checkBreakdown([][2]int{{2, 0}, {2, 0}}, RightHanded, 4, 0)
+	checkBreakdown([][2]int{{3, 0}}, LeftHanded, 3, 0)
+	checkBreakdown([][2]int{{1, 1}, {1, 1}}, RightHanded, 3, 0)
+	checkBreakdown([][2]int{{2, 0}, {1, 1}}, RightHanded, 2, 2)
+	checkBreakdown([][2]int{{2, 1}}, RightHanded, 2, 1)
+	checkBreakdown([][2]int{{2, 1}}, LeftHanded, 1, 2)
+	checkBreakdown([][2]int{{2, 1}, {2, 0}}, RightHanded, 4, 2)
+}
+
+func TestGGSubdivisionProjection(t *testing.T) {
+	checkRadius := func(gg *Geodesic, radius float64) {
+		for _, v := range gg.vertices {
+			d := r3.Distance(origin, gg.VertexPosition(v))
+			if math.Abs(d-radius) > 1e-9 {
+				t.Fatalf("Vertex %v has distance %v from the origin instead of %v", v, d, radius)
+			}
+		}
+	}
+
+	spherical := NewIcosahedralGeodesic()
+	if err := spherical.Subdivide(8, 0, WithProjection(SphericalProjection)); err != nil {
+		t.Fatalf("Legal subdivision failed: %v", err)
+	}
+	checkRadius(spherical, 1)
+	errs := IcosahedralGeodesicIntegrityChecker(*spherical).CheckIntegrity()
+	if len(errs) != 0 {
+		t.Errorf("Spherical subdivision created illegal GG: %v", errs)
+	}
+
+	custom := NewIcosahedralGeodesic()
+	if err := custom.Subdivide(2, 1, WithProjection(SphericalProjectionWithRadius(3))); err != nil {
+		t.Fatalf("Legal subdivision failed: %v", err)
+	}
+	checkRadius(custom, 3)
+	if err := custom.Subdivide(2, 0, WithProjection(SphericalProjection)); err != nil {
+		t.Fatalf("Legal subdivision failed: %v", err)
+	}
+	checkRadius(custom, 3)
+}
+
+func TestGGSubdivisionPartition(t *testing.T) {
+	methods := []PartitionMethod{EqualChordPartition, EqualArcPartition, KennerPartition, MappedPartition}
+	for _, method := range methods {
+		for _, mn := range [][2]int{{4, 0}, {2, 2}, {2, 1}} {
+			gg := NewIcosahedralGeodesic()
+			err := gg.Subdivide(mn[0], mn[1], WithPartition(method), WithProjection(SphericalProjection))
+			if err != nil {
+				t.Fatalf("Legal subdivision failed: %v", err)
+			}
+			errs := IcosahedralGeodesicIntegrityChecker(*gg).CheckIntegrity()
+			if len(errs) != 0 {
+				t.Errorf("Partition %v of (%v,%v) created illegal GG: %v", method, mn[0], mn[1], errs)
+			}
+			for _, v := range gg.vertices {
+				d := r3.Distance(origin, gg.VertexPosition(v))
+				if math.Abs(d-1) > 1e-9 {
+					t.Fatalf("Partition %v placed vertex %v at distance %v from the origin", method, v, d)
+				}
+			}
+		}
+	}
+
+	// The vertex with the weights (2,1,1) relative to the corners of a base Face of a (4,0) subdivision lies on the
+	// bisector through the first corner, at an angle from it that depends on the partition method.
+	base := NewIcosahedralGeodesic()
+	corners := base.Faces()[0].Loop()
+	a, b, c := base.VertexPosition(corners[0]), base.VertexPosition(corners[1]), base.VertexPosition(corners[2])
+	target := origin.VectorTo(a).Scale(2).Add(origin.VectorTo(b)).Add(origin.VectorTo(c))
+	angles := map[PartitionMethod]float64{
+		EqualChordPartition: math.Atan(0.5),
+		EqualArcPartition:   0.479542493514,
+		KennerPartition:     0.478637712596,
+		MappedPartition:     0.479099031823,
+	}
+	for _, method := range methods {
+		gg := NewIcosahedralGeodesic()
+		err := gg.Subdivide(4, 0, WithPartition(method), WithProjection(SphericalProjection))
+		if err != nil {
+			t.Fatalf("Legal subdivision failed: %v", err)
+		}
+		var closest r3.Vector
+		for _, v := range gg.vertices {
+			d := origin.VectorTo(gg.VertexPosition(v))
+			if closest == (r3.Vector{}) || d.Angle(target) < closest.Angle(target) {
+				closest = d
+			}
+		}
+		ab, ac := closest.Angle(origin.VectorTo(b)), closest.Angle(origin.VectorTo(c))
+		if math.Abs(ab-ac) > 1e-9 {
+			t.Errorf("Partition %v placed the (2,1,1) vertex off the bisector: %v and %v", method, ab, ac)
+		}
+		if angle := closest.Angle(origin.VectorTo(a)); math.Abs(angle-angles[method]) > 1e-9 {
+			t.Errorf("Partition %v placed the (2,1,1) vertex at %v from the corner, expected %v", method, angle,
+				angles[method])
+		}
+	}
+}
+
+func TestGGSubdivisionEqualArcPartition(t *testing.T) {
+	ico := NewIcosahedralGeodesic()
+	e := ico.Edges()[0]
+	ev := e.Vertices()
+	a, b := origin.VectorTo(ico.VertexPosition(ev[0])), origin.VectorTo(ico.VertexPosition(ev[1]))
+
+	gg := NewIcosahedralGeodesic()
+	err := gg.Subdivide(4, 0, WithPartition(EqualArcPartition))
+	if err != nil {
+		t.Fatalf("Legal subdivision failed: %v", err)
+	}
+	// All vertices on the great circle arc of the base edge need to be separated by equal angles.
+	arc := a.Angle(b)
+	normal := a.Cross(b).Normalised()
+	onArc := 0
+	for _, v := range gg.vertices {
+		d := origin.VectorTo(gg.VertexPosition(v)).Normalised()
+		if math.Abs(d.Dot(normal)) > 1e-9 || d.Angle(a) > arc || d.Angle(b) > arc {
+			continue
+		}
+		onArc++
+		steps := d.Angle(a) / (arc / 4)
+		if math.Abs(steps-math.Floor(steps+0.5)) > 1e-9 {
+			t.Errorf("Vertex %v is not at an equal arc division of the base edge: %v", v, steps)
+		}
+	}
+	if onArc != 5 {
+		t.Errorf("Found %v vertices on the base edge instead of 5", onArc)
+	}
+}
+
+func TestGGClone(t *testing.T) {
+	gg := NewIcosahedralGeodesic()
+	if err := gg.Subdivide(2, 0); err != nil {
+		t.Fatalf("Legal subdivision failed: %v", err)
+	}
+	positions := make([]r3.Point, len(gg.vertices))
+	for i, v := range gg.vertices {
+		positions[i] = gg.VertexPosition(v)
+	}
+
+	clone := gg.Clone()
+	if err := clone.Subdivide(2, 0, WithProjection(SphericalProjectionWithRadius(2))); err != nil {
+		t.Fatalf("Legal subdivision failed: %v", err)
+	}
+	clone.SetVertexPosition(clone.vertices[0], r3.Point{X: 5, Y: 5, Z: 5})
+
+	if len(gg.faces) != 20*4 || len(gg.Edges()) != 30*4 || len(gg.vertices) != 10*4+2 {
+		t.Errorf("Subdividing the clone changed the original to %v faces, %v edges and %v vertices",
+			len(gg.faces), len(gg.Edges()), len(gg.vertices))
+	}
+	if gg.m != 2 || gg.n != 0 {
+		t.Errorf("Subdividing the clone changed the breakdown of the original to (%v,%v)", gg.m, gg.n)
+	}
+	if clone.m != 4 || clone.n != 0 || len(clone.faces) != 20*16 {
+		t.Errorf("Clone has breakdown (%v,%v) with %v faces", clone.m, clone.n, len(clone.faces))
+	}
+	for i, v := range gg.vertices {
+		if gg.VertexPosition(v) != positions[i] {
+			t.Fatalf("Modifying the clone moved vertex %v of the original", v)
+		}
+	}
+	for _, f := range gg.faces {
+		for _, e := range f.Edges() {
+			for _, ef := range gg.EdgeAdjacentFaces(e) {
+				for _, v := range ef.Loop() {
+					if _, ok := gg.positions[v]; !ok {
+						t.Fatalf("Original refers to vertex %v that was only created in the clone", v)
+					}
+				}
+			}
+		}
+	}
+	errs := IcosahedralGeodesicIntegrityChecker(*gg).CheckIntegrity()
+	if len(errs) != 0 {
+		t.Errorf("Original is in illegal state after modifying the clone: %v", errs)
+	}
+}
+
+func TestNonIcosahedralGeodesicSubdivision(t *testing.T) {
+	bases := []struct {
+		name   string
+		create func() *Geodesic
+		base   baseSolid
+	}{
+		{"tetrahedron", NewTetrahedralGeodesic, tetrahedronBase},
+		{"octahedron", NewOctahedralGeodesic, octahedronBase},
+	}
+	for _, b := range bases {
+		for _, mn := range [][2]int{{1, 0}, {2, 0}, {3, 0}, {1, 1}, {2, 1}} {
+			m, n := mn[0], mn[1]
+			gg := b.create()
+			err := gg.Subdivide(m, n, WithProjection(SphericalProjection))
+			if err != nil {
+				t.Fatalf("Legal subdivision failed: %v", err)
+			}
+			T := m*m + m*n + n*n
+			if len(gg.faces) != b.base.faces*T {
+				t.Errorf("%v (%v,%v) has %v faces instead of %v", b.name, m, n, len(gg.faces), b.base.faces*T)
+			}
+			if len(gg.Edges()) != b.base.edges*T {
+				t.Errorf("%v (%v,%v) has %v edges instead of %v", b.name, m, n, len(gg.Edges()), b.base.edges*T)
+			}
+			if len(gg.vertices) != (b.base.vertices-2)*T+2 {
+				t.Errorf("%v (%v,%v) has %v vertices instead of %v", b.name, m, n, len(gg.vertices), (b.base.vertices-2)*T+2)
+			}
+			errs := GeodesicIntegrityChecker(*gg).CheckIntegrity()
+			if len(errs) != 0 {
+				t.Errorf("Subdivision (%v,%v) of %v created illegal structure: %v", m, n, b.name, errs)
+			}
+			assertNoIntegrityErrors(gg, t)
+		}
+	}
+}
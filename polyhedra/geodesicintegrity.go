@@ -9,24 +9,37 @@ import (
 	"github.com/MichaelMauderer/polyhedra/r3"
 )
 
+// GeodesicIntegrityChecker allows to check for certain features of a geodesic. The expected numbers of faces, edges and
+// vertices as well as the vertex degrees are derived from the base solid of the geodesic.
+// This is mostly useful for debugging algorithms that modify a Polyhedron to check that certain properties are not violated.
+type GeodesicIntegrityChecker Geodesic
+
 // IcosahedralGeodesicIntegrityChecker allows to check for certain features of an icosahedral geodesic.
 // This is mostly useful for debugging algorithms that modify a Polyhedron to check that certain properties are not violated.
 type IcosahedralGeodesicIntegrityChecker IcosahedralGeodesic
 
-// checkFaces checks that the number of faces is a multiple of 20.
-func (gic IcosahedralGeodesicIntegrityChecker) checkFaces() error {
+// CheckIntegrity performs the checks of GeodesicIntegrityChecker assuming an icosahedron as base solid.
+func (gic IcosahedralGeodesicIntegrityChecker) CheckIntegrity() []error {
+	gg := Geodesic(gic)
+	gg.base = icosahedronBase
+	return GeodesicIntegrityChecker(gg).CheckIntegrity()
+}
+
+// checkFaces checks that the number of faces is a multiple of the number of faces of the base solid.
+func (gic GeodesicIntegrityChecker) checkFaces() error {
 	faceNum := len(gic.faces)
-	if faceNum%20 != 0 {
-		return errors.New("number of faces is not a multiple of 20")
+	if faceNum%gic.base.faces != 0 {
+		return fmt.Errorf("number of faces is not a multiple of %v", gic.base.faces)
 	}
 	return nil
 }
 
-// checkEdges checks that the number of faces is a multiple of 30 and no edges are degenerate.
-func (gic IcosahedralGeodesicIntegrityChecker) checkEdges() error {
+// checkEdges checks that the number of edges is a multiple of the number of edges of the base solid and no edges are
+// degenerate.
+func (gic GeodesicIntegrityChecker) checkEdges() error {
 	edgeNum := len(gic.Edges())
-	if edgeNum%30 != 0 {
-		return errors.New("number of faces is not a multiple of 30")
+	if edgeNum%gic.base.edges != 0 {
+		return fmt.Errorf("number of edges is not a multiple of %v", gic.base.edges)
 	}
 	for _, edge := range gic.Edges() {
 		ev := edge.Vertices()
@@ -34,7 +47,7 @@ func (gic IcosahedralGeodesicIntegrityChecker) checkEdges() error {
 			return errors.New("edges contain illegal self-loops")
 		}
 		zero := r3.Point{X: 0.0, Y: 0.0, Z: 0.0}
-		if edge.Center() == zero {
+		if gic.EdgeCenter(edge) == zero {
 			return fmt.Errorf("contains Edge %v centered at zero with vertices %v to %v", edge, ev[0].String(), ev[1].String())
 		}
 
@@ -42,37 +55,39 @@ func (gic IcosahedralGeodesicIntegrityChecker) checkEdges() error {
 	return nil
 }
 
-// checkVertexNum checks that the number of vertices fulfills Vn=(n*10+2).
-func (gic IcosahedralGeodesicIntegrityChecker) checkVertexNum() error {
+// checkVertexNum checks that the number of vertices fulfills V=(T*(V0-2)+2), where V0 is the number of vertices of
+// the base solid. For an icosahedral geodesic this is V=(T*10+2).
+func (gic GeodesicIntegrityChecker) checkVertexNum() error {
 	vertexNum := len(gic.vertices)
-	if (vertexNum-2)%10 != 0 {
-		return errors.New("number of vertices does not fulfill V=(T*10+2)")
+	k := gic.base.vertices - 2
+	if (vertexNum-2)%k != 0 {
+		return fmt.Errorf("number of vertices does not fulfill V=(T*%v+2)", k)
 	}
 	return nil
 }
 
-// checkVertexDegrees checks that each vertex has degree 5 or 6.
-func (gic IcosahedralGeodesicIntegrityChecker) checkVertexDegrees() error {
+// checkVertexDegrees checks that each vertex has the vertex degree of the base solid or 6.
+func (gic GeodesicIntegrityChecker) checkVertexDegrees() error {
 	foundWrongOne := false
 	for _, vertex := range gic.vertices {
 		if vertex == 0 {
 			return fmt.Errorf("contains illegal zero vertex")
 		}
 		vD := gic.VertexDegree(vertex)
-		if (vD != 5) && (vD != 6) {
+		if (vD != gic.base.vertexDegree) && (vD != 6) {
 			log.Printf("Vertex %v in %v has degree %v", vertex, gic, vD)
 			foundWrongOne = true
 		}
 	}
 	if foundWrongOne {
-		return fmt.Errorf("found invalid number of edges at vertex. Should be 5 or 6")
+		return fmt.Errorf("found invalid number of edges at vertex. Should be %v or 6", gic.base.vertexDegree)
 	}
 	return nil
 }
 
 // checkDistinctVertexNeighbors checks that there are no degenerate neighborhood relationships.
 // This means checking that no vertex is its own neighbour and no neighbour appears twice.
-func (gic IcosahedralGeodesicIntegrityChecker) checkDistinctVertexNeighbors() error {
+func (gic GeodesicIntegrityChecker) checkDistinctVertexNeighbors() error {
 	for _, vertex := range gic.vertices {
 		neighbors := gic.AdjacentVertices(vertex)
 		counts := make(map[Vertex]int)
@@ -92,11 +107,11 @@ func (gic IcosahedralGeodesicIntegrityChecker) checkDistinctVertexNeighbors() er
 }
 
 // checkVertexDistances checks that all vertex distances are about the same.
-func (gic IcosahedralGeodesicIntegrityChecker) checkVertexDistances() error {
-	baseLineDistance := gic.Edges()[0].Length()
+func (gic GeodesicIntegrityChecker) checkVertexDistances() error {
+	baseLineDistance := gic.EdgeLength(gic.Edges()[0])
 	epsilon := 0.2
 	for _, edge := range gic.Edges() {
-		dist := edge.Length()
+		dist := gic.EdgeLength(edge)
 		delta := math.Abs(dist - baseLineDistance)
 		if delta > epsilon {
 			return fmt.Errorf("edge %v deviates in length too much: %v with a baseline of %v", edge, delta, baseLineDistance)
@@ -106,11 +121,11 @@ func (gic IcosahedralGeodesicIntegrityChecker) checkVertexDistances() error {
 }
 
 // checkCenter checks that the Polyhedron is centered at (0,0,0).
-func (gic IcosahedralGeodesicIntegrityChecker) checkCenter() error {
+func (gic GeodesicIntegrityChecker) checkCenter() error {
 	vertices := gic.vertices
 	positions := make([]r3.Point, len(vertices))
 	for i := range vertices {
-		positions[i] = vertices[i].Position()
+		positions[i] = gic.VertexPosition(vertices[i])
 	}
 	center := r3.Centroid3D(positions)
 	epsilon := 0.000001
@@ -123,7 +138,7 @@ func (gic IcosahedralGeodesicIntegrityChecker) checkCenter() error {
 
 // CheckIntegrity performs a number of different sanity checks. All violated checks will return an error that
 // is returned in the resulting error slice. If no error found an empty slice is returned.
-func (gic IcosahedralGeodesicIntegrityChecker) CheckIntegrity() []error {
+func (gic GeodesicIntegrityChecker) CheckIntegrity() []error {
 
 	var checks = []func() error{
 		gic.checkFaces,
@@ -0,0 +1,32 @@
+package polyhedra
+
+// The lattice subdivision places the corners of every triangular Face on the points (0,0), (m,n) and (-n,m+n) of a
+// triangular lattice with the axial basis e1=(1,0) and e2=(1/2,sqrt(3)/2). The triangles of the lattice then form the
+// faces of the subdivided Polyhedron. Triangles that straddle an Edge of the base Face are resolved by unfolding the
+// neighbouring Face into the plane of the current one. Geodesic.Subdivide uses the triangularLattice of
+// GoldbergCoxeter for this.
+
+// latticePoint is a point of the triangular lattice in axial coordinates.
+type latticePoint struct {
+	x, y int
+}
+
+// latticeWeight is the integer barycentric weight of a lattice point with respect to one corner of a base Face.
+type latticeWeight struct {
+	v Vertex
+	w int
+}
+
+// latticeKey identifies a subdivision vertex through its non-zero weights ordered by vertex.
+// Weights are scaled by the lattice so they are always integers. There is room for the four corners of a
+// quadrilateral.
+type latticeKey [4]latticeWeight
+
+// latticeWeights returns the barycentric weights of p relative to the corners (0,0), (m,n) and (-n,m+n),
+// scaled by T=m*m+m*n+n*n.
+func latticeWeights(p latticePoint, m, n int) [3]int {
+	t := m*m + m*n + n*n
+	wb := p.x*(m+n) + p.y*n
+	wc := m*p.y - n*p.x
+	return [3]int{t - wb - wc, wb, wc}
+}
@@ -0,0 +1,138 @@
+package polyhedra
+
+import (
+	"math"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// PartitionMethod determines how the faces of a geodesic are partitioned into the faces of the subdivision.
+// For more information see https://en.wikibooks.org/wiki/Geodesic_Grids
+type PartitionMethod int
+
+const (
+	// EqualChordPartition (Method 1) divides the edges and faces of the geodesic into equal parts in the plane of
+	// each Face. Combined with a spherical projection this results in equal chords along the edges of the base.
+	EqualChordPartition PartitionMethod = iota
+	// EqualArcPartition (Method 2) divides the great circle arcs between the corners of each Face into equal arcs.
+	// Points within a Face are placed at the spherical weighted mean of the corners, which reduces to slerp on edges.
+	EqualArcPartition
+	// KennerPartition divides the edges into equal arcs and places each point within a Face at the center of the
+	// small triangle that is formed by the three great circles through the corresponding points on the edges.
+	KennerPartition
+	// MappedPartition maps the planar partition of each Face onto the sphere by interpolating along great circles.
+	// For each corner the point is interpolated along the two adjacent edges and then between the results, the three
+	// candidates are then averaged.
+	MappedPartition
+)
+
+// WithPartition selects the partition method of the subdivision. The default is EqualChordPartition.
+// All partition methods except EqualChordPartition place new vertices on a sphere, so they are usually combined with
+// SphericalProjection to also move the existing vertices onto that sphere.
+func WithPartition(method PartitionMethod) SubdivisionOption {
+	return func(c *subdivisionConfig) {
+		c.partition = method
+	}
+}
+
+// point returns the position of the point with the given weights relative to the given points.
+func (pm PartitionMethod) point(points []r3.Point, weights []float64) r3.Point {
+	if pm == EqualChordPartition {
+		return r3.WeightedCentroid(points, weights)
+	}
+
+	sum := 0.0
+	for _, w := range weights {
+		sum += w
+	}
+	// Spherical methods work on the directions of the points and interpolate the radius.
+	radius := 0.0
+	directions := make([]r3.Vector, 0, len(points))
+	lambdas := make([]float64, 0, len(points))
+	for i, p := range points {
+		if weights[i] == 0 {
+			continue
+		}
+		d := origin.VectorTo(p)
+		radius += d.Length() * weights[i] / sum
+		directions = append(directions, d.Normalised())
+		lambdas = append(lambdas, weights[i]/sum)
+	}
+
+	var direction r3.Vector
+	switch {
+	case len(directions) == 1:
+		direction = directions[0]
+	case len(directions) == 2:
+		direction = r3.Slerp(directions[0], directions[1], lambdas[1])
+	case pm == KennerPartition:
+		direction = kennerDirection(directions, lambdas)
+	case pm == MappedPartition:
+		direction = mappedDirection(directions, lambdas)
+	default:
+		direction = sphericalMean(directions, lambdas)
+	}
+	return origin.Add(direction.Scale(radius))
+}
+
+// sphericalMean returns the unit vector that minimises the weighted sum of squared arc lengths to the given unit
+// vectors. It is computed iteratively in the tangent space of the current estimate.
+func sphericalMean(directions []r3.Vector, lambdas []float64) r3.Vector {
+	var q r3.Vector
+	for i, d := range directions {
+		q = q.Add(d.Scale(lambdas[i]))
+	}
+	q = q.Normalised()
+	for iteration := 0; iteration < 100; iteration++ {
+		var step r3.Vector
+		for i, d := range directions {
+			tangent := d.Add(q.Scale(-q.Dot(d)))
+			if tangent.Length() < 1e-15 {
+				continue
+			}
+			step = step.Add(tangent.Normalised().Scale(lambdas[i] * q.Angle(d)))
+		}
+		angle := step.Length()
+		if angle < 1e-14 {
+			break
+		}
+		q = q.Scale(math.Cos(angle)).Add(step.Normalised().Scale(math.Sin(angle)))
+	}
+	return q
+}
+
+// kennerDirection intersects the great circles of constant weight for each corner and returns the normalised
+// centroid of the three intersections.
+func kennerDirection(directions []r3.Vector, lambdas []float64) r3.Vector {
+	var outward r3.Vector
+	normals := make([]r3.Vector, 3)
+	for i := range directions {
+		j, k := (i+1)%3, (i+2)%3
+		p := r3.Slerp(directions[i], directions[j], 1-lambdas[i])
+		q := r3.Slerp(directions[i], directions[k], 1-lambdas[i])
+		normals[i] = p.Cross(q)
+		outward = outward.Add(directions[i])
+	}
+	var sum r3.Vector
+	for i := range normals {
+		intersection := normals[i].Cross(normals[(i+1)%3]).Normalised()
+		if intersection.Dot(outward) < 0 {
+			intersection = intersection.Scale(-1)
+		}
+		sum = sum.Add(intersection)
+	}
+	return sum.Normalised()
+}
+
+// mappedDirection interpolates along great circles starting from each corner and returns the normalised mean of the
+// three results.
+func mappedDirection(directions []r3.Vector, lambdas []float64) r3.Vector {
+	var sum r3.Vector
+	for i := range directions {
+		j, k := (i+1)%3, (i+2)%3
+		p := r3.Slerp(directions[i], directions[j], 1-lambdas[i])
+		q := r3.Slerp(directions[i], directions[k], 1-lambdas[i])
+		sum = sum.Add(r3.Slerp(p, q, lambdas[k]/(lambdas[j]+lambdas[k])))
+	}
+	return sum.Normalised()
+}
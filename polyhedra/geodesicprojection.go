@@ -0,0 +1,64 @@
+package polyhedra
+
+import (
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// origin is the point (0,0,0) that spherical projections are centered on.
+var origin = r3.Point{X: 0, Y: 0, Z: 0}
+
+// Projection determines where the vertices of a subdivided geodesic are placed.
+type Projection struct {
+	spherical bool
+	radius    float64
+}
+
+// PlanarProjection keeps the new vertices on the faces of the Polyhedron that is subdivided.
+var PlanarProjection = Projection{}
+
+// SphericalProjection moves all vertices onto the circumscribed sphere of the Polyhedron that is subdivided.
+// The radius of the sphere is the mean distance of the vertices from the origin before the subdivision.
+var SphericalProjection = Projection{spherical: true}
+
+// SphericalProjectionWithRadius moves all vertices onto the sphere with the given radius around the origin.
+func SphericalProjectionWithRadius(radius float64) Projection {
+	return Projection{spherical: true, radius: radius}
+}
+
+// WithProjection selects the projection of the vertices of the subdivision. The default is PlanarProjection.
+func WithProjection(p Projection) SubdivisionOption {
+	return func(c *subdivisionConfig) {
+		c.projection = p
+	}
+}
+
+// onSphere returns the point with the same direction as p that lies on the sphere with the given radius around the
+// origin.
+func onSphere(p r3.Point, radius float64) r3.Point {
+	return origin.Add(origin.VectorTo(p).Normalised().Scale(radius))
+}
+
+// meanRadius returns the mean distance of the vertices of the Polyhedron from the origin.
+func (p *Polyhedron) meanRadius() float64 {
+	sum := 0.0
+	for _, v := range p.vertices {
+		sum += r3.Distance(origin, p.positions[v])
+	}
+	return sum / float64(len(p.vertices))
+}
+
+// withBaseRadius returns the projection with its radius set to the given radius unless it specifies its own radius.
+func (p Projection) withBaseRadius(radius float64) Projection {
+	if p.radius <= 0 {
+		p.radius = radius
+	}
+	return p
+}
+
+// project applies the projection to the given point.
+func (p Projection) project(point r3.Point) r3.Point {
+	if !p.spherical {
+		return point
+	}
+	return onSphere(point, p.radius)
+}
@@ -0,0 +1,289 @@
+package polyhedra
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// The glTF writers describe the Polyhedron as a single mesh with one triangle primitive in a single node. Faces are
+// split into fans of triangles, the data lives in one buffer with a view for every attribute and the indices.
+// For the format see https://registry.khronos.org/glTF/specs/2.0/glTF-2.0.html
+
+// Constants of the glTF specification.
+const (
+	gltfFloat        = 5126
+	gltfUnsignedInt  = 5125
+	gltfArrayBuffer  = 34962
+	gltfElementArray = 34963
+	gltfTriangles    = 4
+	glbMagic         = 0x46546C67
+	glbJSONChunk     = 0x4E4F534A
+	glbBinChunk      = 0x004E4942
+)
+
+// GLTFOption configures optional aspects of WriteGLTF and WriteGLB.
+type GLTFOption func(*gltfConfig)
+
+// gltfConfig collects the options of WriteGLTF and WriteGLB.
+type gltfConfig struct {
+	flat   bool
+	colors []color.Color
+}
+
+// WithGLTFFlatShading gives every Face its own copies of its vertices with the normal of the Face, so the faces are
+// shaded flat. By default vertices are shared between faces and have the mean normal of their faces.
+func WithGLTFFlatShading() GLTFOption {
+	return func(c *gltfConfig) {
+		c.flat = true
+	}
+}
+
+// WithGLTFFaceColors colours every Face with the colour at its position in the order of Faces. The colours are
+// written as vertex colours, which implies flat shading.
+func WithGLTFFaceColors(colors []color.Color) GLTFOption {
+	return func(c *gltfConfig) {
+		c.colors = colors
+		c.flat = true
+	}
+}
+
+// gltfDocument is the JSON part of a glTF asset.
+type gltfDocument struct {
+	Asset       gltfAsset        `json:"asset"`
+	Scene       int              `json:"scene"`
+	Scenes      []gltfScene      `json:"scenes"`
+	Nodes       []gltfNode       `json:"nodes"`
+	Meshes      []gltfMesh       `json:"meshes"`
+	Accessors   []gltfAccessor   `json:"accessors"`
+	BufferViews []gltfBufferView `json:"bufferViews"`
+	Buffers     []gltfBuffer     `json:"buffers"`
+}
+
+type gltfAsset struct {
+	Version   string `json:"version"`
+	Generator string `json:"generator,omitempty"`
+}
+
+type gltfScene struct {
+	Nodes []int `json:"nodes"`
+}
+
+type gltfNode struct {
+	Mesh int `json:"mesh"`
+}
+
+type gltfMesh struct {
+	Primitives []gltfPrimitive `json:"primitives"`
+}
+
+type gltfPrimitive struct {
+	Attributes map[string]int `json:"attributes"`
+	Indices    int            `json:"indices"`
+	Mode       int            `json:"mode"`
+}
+
+type gltfAccessor struct {
+	BufferView    int       `json:"bufferView"`
+	ComponentType int       `json:"componentType"`
+	Count         int       `json:"count"`
+	Type          string    `json:"type"`
+	Min           []float64 `json:"min,omitempty"`
+	Max           []float64 `json:"max,omitempty"`
+}
+
+type gltfBufferView struct {
+	Buffer     int `json:"buffer"`
+	ByteOffset int `json:"byteOffset"`
+	ByteLength int `json:"byteLength"`
+	Target     int `json:"target"`
+}
+
+type gltfBuffer struct {
+	ByteLength int    `json:"byteLength"`
+	URI        string `json:"uri,omitempty"`
+}
+
+// gltfMeshData holds the vertex attributes and the triangle indices of the mesh.
+type gltfMeshData struct {
+	positions []r3.Point
+	normals   []r3.Vector
+	colors    []color.Color
+	indices   []uint32
+}
+
+// newGLTFMeshData collects the vertex attributes and triangles of the Polyhedron.
+func newGLTFMeshData(p Interface, config gltfConfig) (gltfMeshData, error) {
+	faces := p.Faces()
+	if config.colors != nil && len(config.colors) != len(faces) {
+		return gltfMeshData{}, fmt.Errorf("got %v colours for %v faces", len(config.colors), len(faces))
+	}
+	normals := make([]r3.Vector, len(faces))
+	for i, f := range faces {
+		normals[i] = faceNormal(p, f)
+	}
+
+	data := gltfMeshData{}
+	if config.flat {
+		for i, f := range faces {
+			first := uint32(len(data.positions))
+			loop := f.Loop()
+			for _, v := range loop {
+				data.positions = append(data.positions, p.VertexPosition(v))
+				data.normals = append(data.normals, normals[i])
+				if config.colors != nil {
+					data.colors = append(data.colors, config.colors[i])
+				}
+			}
+			for j := uint32(1); int(j)+1 < len(loop); j++ {
+				data.indices = append(data.indices, first, first+j, first+j+1)
+			}
+		}
+		return data, nil
+	}
+
+	indices := vertexIndices(p)
+	sums := make([]r3.Vector, len(indices))
+	for i, f := range faces {
+		for _, v := range f.Loop() {
+			sums[indices[v]] = sums[indices[v]].Add(normals[i])
+		}
+	}
+	for i, v := range p.Vertices() {
+		data.positions = append(data.positions, p.VertexPosition(v))
+		data.normals = append(data.normals, sums[i].Normalised())
+	}
+	for _, f := range faces {
+		for _, triangle := range fanTriangles(f) {
+			for _, v := range triangle {
+				data.indices = append(data.indices, uint32(indices[v]))
+			}
+		}
+	}
+	return data, nil
+}
+
+// encode returns the glTF document and the content of its buffer.
+func (data gltfMeshData) encode() (gltfDocument, []byte) {
+	var buf bytes.Buffer
+	doc := gltfDocument{
+		Asset:  gltfAsset{Version: "2.0", Generator: "polyhedra"},
+		Scenes: []gltfScene{{Nodes: []int{0}}},
+		Nodes:  []gltfNode{{Mesh: 0}},
+	}
+	primitive := gltfPrimitive{Attributes: make(map[string]int), Mode: gltfTriangles}
+
+	// addView appends the values to the buffer and adds a view and an accessor for them.
+	addView := func(values []float32, componentType int, count int, kind string, target int) int {
+		offset := buf.Len()
+		binary.Write(&buf, binary.LittleEndian, values)
+		doc.BufferViews = append(doc.BufferViews, gltfBufferView{0, offset, buf.Len() - offset, target})
+		doc.Accessors = append(doc.Accessors, gltfAccessor{BufferView: len(doc.BufferViews) - 1,
+			ComponentType: componentType, Count: count, Type: kind})
+		return len(doc.Accessors) - 1
+	}
+
+	positions := make([]float32, 0, 3*len(data.positions))
+	lower := []float64{math.Inf(1), math.Inf(1), math.Inf(1)}
+	upper := []float64{math.Inf(-1), math.Inf(-1), math.Inf(-1)}
+	for _, pos := range data.positions {
+		for i, x := range []float32{float32(pos.X), float32(pos.Y), float32(pos.Z)} {
+			positions = append(positions, x)
+			lower[i] = math.Min(lower[i], float64(x))
+			upper[i] = math.Max(upper[i], float64(x))
+		}
+	}
+	primitive.Attributes["POSITION"] = addView(positions, gltfFloat, len(data.positions), "VEC3", gltfArrayBuffer)
+	doc.Accessors[len(doc.Accessors)-1].Min = lower
+	doc.Accessors[len(doc.Accessors)-1].Max = upper
+
+	normals := make([]float32, 0, 3*len(data.normals))
+	for _, n := range data.normals {
+		normals = append(normals, float32(n.X), float32(n.Y), float32(n.Z))
+	}
+	primitive.Attributes["NORMAL"] = addView(normals, gltfFloat, len(data.normals), "VEC3", gltfArrayBuffer)
+
+	if data.colors != nil {
+		colors := make([]float32, 0, 4*len(data.colors))
+		for _, c := range data.colors {
+			// glTF expects colours without premultiplied alpha.
+			n := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+			colors = append(colors, float32(n.R)/0xffff, float32(n.G)/0xffff, float32(n.B)/0xffff, float32(n.A)/0xffff)
+		}
+		primitive.Attributes["COLOR_0"] = addView(colors, gltfFloat, len(data.colors), "VEC4", gltfArrayBuffer)
+	}
+
+	offset := buf.Len()
+	binary.Write(&buf, binary.LittleEndian, data.indices)
+	doc.BufferViews = append(doc.BufferViews, gltfBufferView{0, offset, buf.Len() - offset, gltfElementArray})
+	doc.Accessors = append(doc.Accessors, gltfAccessor{BufferView: len(doc.BufferViews) - 1,
+		ComponentType: gltfUnsignedInt, Count: len(data.indices), Type: "SCALAR"})
+	primitive.Indices = len(doc.Accessors) - 1
+
+	doc.Meshes = []gltfMesh{{Primitives: []gltfPrimitive{primitive}}}
+	doc.Buffers = []gltfBuffer{{ByteLength: buf.Len()}}
+	return doc, buf.Bytes()
+}
+
+// WriteGLTF writes the Polyhedron to w as a self-contained glTF 2.0 asset in JSON, with the buffer embedded as a
+// base64 data URI. The asset has position, normal and index data and optionally vertex colours, see GLTFOption.
+// Faces with more than three vertices are split into a fan of triangles around their first vertex, which keeps the
+// winding of their loops.
+func WriteGLTF(w io.Writer, p Interface, options ...GLTFOption) error {
+	doc, buf, err := newGLTFAsset(p, options)
+	if err != nil {
+		return err
+	}
+	doc.Buffers[0].URI = "data:application/octet-stream;base64," + base64.StdEncoding.EncodeToString(buf)
+	return json.NewEncoder(w).Encode(doc)
+}
+
+// WriteGLB writes the Polyhedron to w as binary glTF 2.0 asset, which holds the same data as the output of WriteGLTF
+// with the buffer stored in a binary chunk.
+func WriteGLB(w io.Writer, p Interface, options ...GLTFOption) error {
+	doc, buf, err := newGLTFAsset(p, options)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	// Chunks are padded to a multiple of 4 bytes, with spaces for JSON and zeros for binary data.
+	for len(content)%4 != 0 {
+		content = append(content, ' ')
+	}
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+
+	bw := bufio.NewWriter(w)
+	binary.Write(bw, binary.LittleEndian, []uint32{glbMagic, 2, uint32(12 + 8 + len(content) + 8 + len(buf))})
+	binary.Write(bw, binary.LittleEndian, []uint32{uint32(len(content)), glbJSONChunk})
+	bw.Write(content)
+	binary.Write(bw, binary.LittleEndian, []uint32{uint32(len(buf)), glbBinChunk})
+	bw.Write(buf)
+	return bw.Flush()
+}
+
+// newGLTFAsset returns the glTF document and buffer for the Polyhedron.
+func newGLTFAsset(p Interface, options []GLTFOption) (gltfDocument, []byte, error) {
+	config := gltfConfig{}
+	for _, option := range options {
+		option(&config)
+	}
+	data, err := newGLTFMeshData(p, config)
+	if err != nil {
+		return gltfDocument{}, nil, err
+	}
+	doc, buf := data.encode()
+	return doc, buf, nil
+}
@@ -0,0 +1,137 @@
+package polyhedra
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"image/color"
+	"math"
+	"strings"
+	"testing"
+)
+
+// assertValidGLTF checks the structure of the glTF document against its buffer and returns the number of vertices
+// and indices of its primitive.
+func assertValidGLTF(doc gltfDocument, buf []byte, t *testing.T) (vertices, indices int) {
+	if doc.Asset.Version != "2.0" || len(doc.Buffers) != 1 || doc.Buffers[0].ByteLength > len(buf) {
+		t.Fatalf("Invalid asset or buffer: %+v", doc)
+	}
+	for i, view := range doc.BufferViews {
+		if view.ByteOffset%4 != 0 || view.ByteOffset+view.ByteLength > doc.Buffers[0].ByteLength {
+			t.Errorf("Buffer view %v does not fit into the buffer", i)
+		}
+	}
+	sizes := map[string]int{"SCALAR": 1, "VEC3": 3, "VEC4": 4}
+	for i, accessor := range doc.Accessors {
+		view := doc.BufferViews[accessor.BufferView]
+		if accessor.Count*sizes[accessor.Type]*4 != view.ByteLength {
+			t.Errorf("Accessor %v does not match its buffer view", i)
+		}
+	}
+
+	primitive := doc.Meshes[doc.Nodes[doc.Scenes[doc.Scene].Nodes[0]].Mesh].Primitives[0]
+	position := doc.Accessors[primitive.Attributes["POSITION"]]
+	if len(position.Min) != 3 || len(position.Max) != 3 {
+		t.Error("Position accessor needs min and max")
+	}
+	for attribute, i := range primitive.Attributes {
+		if doc.Accessors[i].Count != position.Count {
+			t.Errorf("Attribute %v has %v elements instead of %v", attribute, doc.Accessors[i].Count, position.Count)
+		}
+	}
+	index := doc.Accessors[primitive.Indices]
+	view := doc.BufferViews[index.BufferView]
+	for i := 0; i < index.Count; i++ {
+		if int(binary.LittleEndian.Uint32(buf[view.ByteOffset+4*i:])) >= position.Count {
+			t.Fatalf("Index %v is out of range", i)
+		}
+	}
+	return position.Count, index.Count
+}
+
+func TestWriteGLTF(t *testing.T) {
+	p, err := NewIcosahedralGoldbergPolyhedron(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := WriteGLTF(&out, p); err != nil {
+		t.Fatal(err)
+	}
+	var doc gltfDocument
+	if err := json.Unmarshal(out.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	const prefix = "data:application/octet-stream;base64,"
+	if !strings.HasPrefix(doc.Buffers[0].URI, prefix) {
+		t.Fatalf("Buffer is not embedded: %v", doc.Buffers[0].URI)
+	}
+	buf, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(doc.Buffers[0].URI, prefix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	vertices, indices := assertValidGLTF(doc, buf, t)
+	if vertices != len(p.Vertices()) || indices != 3*(12*3+20*4) {
+		t.Errorf("Expected %v vertices and %v indices, got %v and %v", len(p.Vertices()), 3*(12*3+20*4), vertices,
+			indices)
+	}
+}
+
+func TestWriteGLBWithFaceColors(t *testing.T) {
+	p := NewCube(1)
+	colors := make([]color.Color, len(p.Faces()))
+	for i := range colors {
+		colors[i] = color.RGBA{R: uint8(40 * i), G: 255, B: 0, A: 255}
+	}
+	var out bytes.Buffer
+	if err := WriteGLB(&out, p, WithGLTFFaceColors(colors)); err != nil {
+		t.Fatal(err)
+	}
+	data := out.Bytes()
+	header := make([]uint32, 5)
+	binary.Read(bytes.NewReader(data), binary.LittleEndian, header)
+	if header[0] != glbMagic || header[1] != 2 || int(header[2]) != len(data) || header[4] != glbJSONChunk {
+		t.Fatalf("Invalid GLB header %x", header)
+	}
+	jsonLength := int(header[3])
+	if jsonLength%4 != 0 {
+		t.Error("JSON chunk is not padded")
+	}
+	var doc gltfDocument
+	if err := json.Unmarshal(data[20:20+jsonLength], &doc); err != nil {
+		t.Fatal(err)
+	}
+	binStart := 20 + jsonLength
+	binLength := int(binary.LittleEndian.Uint32(data[binStart:]))
+	if binary.LittleEndian.Uint32(data[binStart+4:]) != glbBinChunk || binStart+8+binLength != len(data) {
+		t.Fatal("Invalid binary chunk")
+	}
+	buf := data[binStart+8:]
+
+	vertices, indices := assertValidGLTF(doc, buf, t)
+	if vertices != 24 || indices != 36 {
+		t.Errorf("Expected 24 vertices and 36 indices, got %v and %v", vertices, indices)
+	}
+	primitive := doc.Meshes[0].Primitives[0]
+	colorView := doc.BufferViews[doc.Accessors[primitive.Attributes["COLOR_0"]].BufferView]
+	normalView := doc.BufferViews[doc.Accessors[primitive.Attributes["NORMAL"]].BufferView]
+	for i := 0; i < vertices; i++ {
+		red := math.Float32frombits(binary.LittleEndian.Uint32(buf[colorView.ByteOffset+16*i:]))
+		if expected := float32(40*(i/4)) / 255; math.Abs(float64(red-expected)) > 1e-6 {
+			t.Errorf("Vertex %v has red %v instead of %v", i, red, expected)
+		}
+		// The corners of a flat shaded Face share the normal of the Face.
+		offset := normalView.ByteOffset + 12*i
+		if !bytes.Equal(buf[offset:offset+12], buf[normalView.ByteOffset+12*(i/4*4):][:12]) {
+			t.Errorf("Vertex %v does not have the normal of its face", i)
+		}
+	}
+}
+
+func TestWriteGLTFRejectsMismatchedColors(t *testing.T) {
+	var out bytes.Buffer
+	if err := WriteGLTF(&out, NewCube(1), WithGLTFFaceColors([]color.Color{color.White})); err == nil {
+		t.Error("Expected an error for too few colours")
+	}
+}
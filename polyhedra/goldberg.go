@@ -5,61 +5,85 @@ package polyhedra
 type GoldbergPolyhedron struct {
 	Polyhedron
 	m, n int
+	// projection is applied to the vertices of the result of Chamfer.
+	projection Projection
+}
+
+// Clone returns a deep copy of the GoldbergPolyhedron. Modifying the copy does not affect the original and vice versa.
+func (gp *GoldbergPolyhedron) Clone() *GoldbergPolyhedron {
+	return &GoldbergPolyhedron{gp.Polyhedron.clone(), gp.m, gp.n, gp.projection}
 }
 
 // GeodesicToGoldberg returns the goldberg Polyhedron that corresponds to the given geodesic Polyhedron.
-// This is achieved by replacing all faces with vertices and adding edges between vertices that corresponded to neighbouring faces.
+// This is achieved by replacing all faces with vertices at their centers and adding edges between vertices that
+// corresponded to neighbouring faces, see Dual.
 func GeodesicToGoldberg(g *Geodesic) (*GoldbergPolyhedron, error) {
-
-	// For each Edge create a new vertex
-	vertexMap := make(map[string]Vertex)
-	newVertices := make([]Vertex, 0)
-	for i := range g.faces {
-		f := g.faces[i]
-		v := NewVertex()
-		vertexMap[f.String()] = v
-		newVertices = append(newVertices, v)
-		v.setPosition(f.Center())
+	d, err := dual(g, dualConfig{placement: CentroidPlacement})
+	if err != nil {
+		return nil, err
 	}
-
-	// Turn adjacent vertices into faces
-	newFaces := make([]Face, 0)
-	for _, v := range g.vertices {
-		af := g.VertexAdjacentFaces(v)
-		loop := make([]Vertex, len(af))
-		for i, f := range af {
-			loop[i] = vertexMap[f.String()]
-		}
-		newFace := NewFace(SortedClockwise(loop))
-		newFaces = append(newFaces, newFace)
+	// The face centers lie inside the sphere of a spherical geodesic, so chamfers project onto the mean radius of the
+	// result instead.
+	projection := PlanarProjection
+	if g.projection.spherical {
+		projection = SphericalProjectionWithRadius(d.meanRadius())
 	}
+	return &GoldbergPolyhedron{d, g.m, g.n, projection}, nil
+}
 
-	newEdges := make([]Edge, 0)
-	for _, e := range g.Edges() {
-		fs := g.EdgeAdjacentFaces(e)
-		v1 := vertexMap[fs[0].String()]
-		v2 := vertexMap[fs[1].String()]
-		newEdges = append(newEdges, NewEdge(v1, v2))
-	}
+// ChamferMapping relates the faces of a chamfered GoldbergPolyhedron to the faces of its parent.
+type ChamferMapping struct {
+	// Children holds the Face of the child that the i-th Face of the parent shrank to.
+	Children []Face
+	// Parents holds the faces of the parent for the i-th Face of the child. Faces that a Face of the parent shrank to
+	// have that Face as their only parent, the hexagons that replace the edges of the parent have the two faces at
+	// the Edge as their parents.
+	Parents [][]Face
+}
 
-	poly := GoldbergPolyhedron{}
-	var err error
-	polyBase, err := NewPolyhedron(newVertices, newEdges, newFaces)
+// Chamfer returns the chamfer of the GoldbergPolyhedron, which turns GP(m,n) into GP(2m,2n). Every Face shrinks
+// and keeps its number of sides, every Edge is replaced by a hexagon. The returned mapping relates the faces of the
+// result to the faces of the GoldbergPolyhedron. See the function Chamfer for the placement of the vertices.
+//
+// If the GoldbergPolyhedron was created from a geodesic with a spherical projection, all vertices of the result are
+// moved onto the sphere around the origin whose radius is the mean distance of the vertices from the origin right
+// after GeodesicToGoldberg. Repeated chamfers keep that radius. Otherwise the vertices are not
+// projected, and the faces of repeated chamfers are in general not planar.
+func (gp *GoldbergPolyhedron) Chamfer() (*GoldbergPolyhedron, *ChamferMapping, error) {
+	c, parents, err := chamfer(gp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if gp.projection.spherical {
+		for _, v := range c.vertices {
+			c.positions[v] = gp.projection.project(c.positions[v])
+		}
 	}
-	poly.Polyhedron = *polyBase
-	poly.m = g.m
-	poly.n = g.n
 
-	return &poly, nil
+	faces, children := gp.Faces(), c.Faces()
+	mapping := ChamferMapping{
+		Children: make([]Face, len(faces)),
+		Parents:  make([][]Face, len(children)),
+	}
+	for i, indices := range parents {
+		for _, j := range indices {
+			mapping.Parents[i] = append(mapping.Parents[i], faces[j])
+		}
+		if len(indices) == 1 {
+			mapping.Children[indices[0]] = children[i]
+		}
+	}
+	return &GoldbergPolyhedron{*c, 2 * gp.m, 2 * gp.n, gp.projection}, &mapping, nil
 }
 
 // NewIcosahedralGoldbergPolyhedron creates a new GoldbergPolyhedron that has an icosahedron as a base and is subdivided
-// according to the breakdown (n,m).
-func NewIcosahedralGoldbergPolyhedron(m int, n int) (*GoldbergPolyhedron, error) {
+// according to the breakdown (m,n). The options are passed on to Geodesic.Subdivide.
+func NewIcosahedralGoldbergPolyhedron(m int, n int, options ...SubdivisionOption) (*GoldbergPolyhedron, error) {
 	baseGeodesic := NewIcosahedralGeodesic()
-	baseGeodesic.Subdivide(m, n)
+	err := baseGeodesic.Subdivide(m, n, options...)
+	if err != nil {
+		return nil, err
+	}
 	result, err := GeodesicToGoldberg(baseGeodesic)
 	return result, err
 }
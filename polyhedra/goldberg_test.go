@@ -1,6 +1,11 @@
 package polyhedra
 
-import "testing"
+import (
+	"math"
+	"testing"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
 
 func assertFaceCount(p Interface, fn int, t *testing.T) {
 	faces := len(p.Faces())
@@ -58,6 +63,56 @@ func TestNewIcosahedralGoldbergPolyhedronCreation(t *testing.T) {
 	assertVertexDegrees(igp, t)
 }
 
+func TestNewIcosahedralGoldbergPolyhedronClassI(t *testing.T) {
+	for _, m := range []int{2, 3, 5, 7, 12} {
+		n := 0
+		igp, err := NewIcosahedralGoldbergPolyhedron(m, n)
+		if err != nil {
+			t.Fatalf("Creation of (m=%v,n=%v) failed: %v", m, n, err)
+		}
+		T := m*n + m*m + n*n
+		assertFaceCount(igp, 10*T+2, t)
+		assertVertexCount(igp, 20*T, t)
+		assertEdgeCount(igp, 30*T, t)
+		assertVertexDegrees(igp, t)
+		assertVertexAdjacentFaceCount(igp, t)
+	}
+}
+
+func TestNewIcosahedralGoldbergPolyhedronClassII(t *testing.T) {
+	for _, m := range []int{1, 2, 3} {
+		n := m
+		igp, err := NewIcosahedralGoldbergPolyhedron(m, n)
+		if err != nil {
+			t.Fatalf("Creation of (m=%v,n=%v) failed: %v", m, n, err)
+		}
+		T := m*n + m*m + n*n
+		assertFaceCount(igp, 10*T+2, t)
+		assertVertexCount(igp, 20*T, t)
+		assertEdgeCount(igp, 30*T, t)
+		assertVertexDegrees(igp, t)
+		assertVertexAdjacentFaceCount(igp, t)
+	}
+}
+
+func TestNewIcosahedralGoldbergPolyhedronClassIII(t *testing.T) {
+	for _, mn := range [][2]int{{2, 1}, {3, 1}, {3, 2}} {
+		for _, h := range []Handedness{RightHanded, LeftHanded} {
+			m, n := mn[0], mn[1]
+			igp, err := NewIcosahedralGoldbergPolyhedron(m, n, WithHandedness(h))
+			if err != nil {
+				t.Fatalf("Creation of (m=%v,n=%v) failed: %v", m, n, err)
+			}
+			T := m*n + m*m + n*n
+			assertFaceCount(igp, 10*T+2, t)
+			assertVertexCount(igp, 20*T, t)
+			assertEdgeCount(igp, 30*T, t)
+			assertVertexDegrees(igp, t)
+			assertVertexAdjacentFaceCount(igp, t)
+		}
+	}
+}
+
 func TestVertexOrder(t *testing.T) {
 	igp1, _ := NewIcosahedralGoldbergPolyhedron(1, 0)
 	igp2, _ := NewIcosahedralGoldbergPolyhedron(1, 0)
@@ -66,7 +121,7 @@ func TestVertexOrder(t *testing.T) {
 
 	epsilon := 0.01
 	for i := range v1 {
-		d := v1[i].Position().VectorTo(v2[i].Position()).Length()
+		d := igp1.VertexPosition(v1[i]).VectorTo(igp2.VertexPosition(v2[i])).Length()
 		if d > epsilon {
 			t.Errorf("Expected vertex order to be the same but vertex %v is %v and %v.", i, v1[i], v2[i])
 
@@ -85,7 +140,7 @@ func TestEdgeOrder(t *testing.T) {
 		e1 := e1S[i]
 		e2 := e2S[i]
 
-		d := e1.Center().VectorTo(e2.Center()).Length()
+		d := igp1.EdgeCenter(e1).VectorTo(igp2.EdgeCenter(e2)).Length()
 		if d > epsilon {
 			t.Log(d)
 			t.Errorf("Expected egde order to be the same but vertex %v is %v and %v.", i, e1, e2)
@@ -105,7 +160,7 @@ func TestFaceOrder(t *testing.T) {
 		f1 := f1S[i]
 		f2 := f2S[i]
 
-		d := f1.Center().VectorTo(f2.Center()).Length()
+		d := igp1.FaceCenter(f1).VectorTo(igp2.FaceCenter(f2)).Length()
 		if d > epsilon {
 			t.Log(d)
 			t.Errorf("Expected Face order to be the same but vertex %v is %v and %v.", i, f1, f2)
@@ -113,3 +168,175 @@ func TestFaceOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestGoldbergClone(t *testing.T) {
+	igp, _ := NewIcosahedralGoldbergPolyhedron(2, 0)
+	clone := igp.Clone()
+	v := clone.Vertices()[0]
+	original := igp.VertexPosition(v)
+	clone.SetVertexPosition(v, original.Add(r3.Vector{X: 1, Y: 0, Z: 0}))
+
+	if igp.VertexPosition(v) != original {
+		t.Errorf("Moving a vertex of the clone moved the original vertex to %v", igp.VertexPosition(v))
+	}
+	if clone.m != igp.m || clone.n != igp.n {
+		t.Errorf("Clone has breakdown (%v,%v) instead of (%v,%v)", clone.m, clone.n, igp.m, igp.n)
+	}
+	assertFaceCount(clone, len(igp.Faces()), t)
+	assertEdgeCount(clone, len(igp.Edges()), t)
+	assertVertexCount(clone, len(igp.Vertices()), t)
+}
+
+func TestNonIcosahedralGoldbergPolyhedra(t *testing.T) {
+	bases := []struct {
+		create func() *Geodesic
+		base   baseSolid
+	}{
+		{NewTetrahedralGeodesic, tetrahedronBase},
+		{NewOctahedralGeodesic, octahedronBase},
+	}
+	for _, b := range bases {
+		for _, mn := range [][2]int{{1, 0}, {2, 0}, {1, 1}, {2, 1}} {
+			m, n := mn[0], mn[1]
+			gg := b.create()
+			if err := gg.Subdivide(m, n); err != nil {
+				t.Fatalf("Legal subdivision failed: %v", err)
+			}
+			gp, err := GeodesicToGoldberg(gg)
+			if err != nil {
+				t.Fatalf("Conversion to Goldberg polyhedron failed: %v", err)
+			}
+			T := m*m + m*n + n*n
+			assertFaceCount(gp, (b.base.vertices-2)*T+2, t)
+			assertVertexCount(gp, b.base.faces*T, t)
+			assertEdgeCount(gp, b.base.edges*T, t)
+			assertVertexDegrees(gp, t)
+			assertNoIntegrityErrors(gp, t)
+
+			small := 0
+			for _, f := range gp.Faces() {
+				if len(f.Loop()) == b.base.vertexDegree {
+					small++
+				}
+			}
+			if small != b.base.vertices {
+				t.Errorf("Goldberg polyhedron has %v faces with %v vertices instead of %v", small, b.base.vertexDegree, b.base.vertices)
+			}
+		}
+	}
+}
+
+func TestGoldbergChamfer(t *testing.T) {
+	for _, mn := range [][2]int{{1, 0}, {1, 1}, {2, 1}} {
+		igp, err := NewIcosahedralGoldbergPolyhedron(mn[0], mn[1], WithProjection(SphericalProjection))
+		if err != nil {
+			t.Fatal(err)
+		}
+		radius := igp.projection.radius
+		// Chamfers are chained to refine the grid repeatedly.
+		for step := 0; step < 3 && len(igp.Faces()) < 2000; step++ {
+			chamfered, mapping, err := igp.Chamfer()
+			if err != nil {
+				t.Fatalf("Chamfer of (m=%v,n=%v) failed: %v", igp.m, igp.n, err)
+			}
+			assertGoldbergChamfer(igp, chamfered, mapping, t)
+			for _, v := range chamfered.Vertices() {
+				if d := r3.Distance(origin, chamfered.VertexPosition(v)); math.Abs(d-radius) > 1e-9 {
+					t.Errorf("Vertex of (m=%v,n=%v) lies at distance %v instead of %v", chamfered.m, chamfered.n,
+						d, radius)
+					break
+				}
+			}
+			igp = chamfered
+		}
+	}
+}
+
+func TestGoldbergChamferWithoutProjection(t *testing.T) {
+	igp, err := NewIcosahedralGoldbergPolyhedron(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chamfered, mapping, err := igp.Chamfer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGoldbergChamfer(igp, chamfered, mapping, t)
+	// The chamfer of the dodecahedron has planar faces, so it is not projected.
+	if issues := NewIntegrityChecker().CheckIntegrity(chamfered); len(issues) != 0 {
+		t.Errorf("Chamfered dodecahedron has integrity issues: %v", issues)
+	}
+}
+
+// assertGoldbergChamfer checks that chamfered is a valid chamfer of igp with a consistent mapping between them.
+func assertGoldbergChamfer(igp, chamfered *GoldbergPolyhedron, mapping *ChamferMapping, t *testing.T) {
+	if chamfered.m != 2*igp.m || chamfered.n != 2*igp.n {
+		t.Errorf("Chamfer has breakdown (%v,%v) instead of (%v,%v)", chamfered.m, chamfered.n, 2*igp.m, 2*igp.n)
+	}
+	T := 4 * (igp.m*igp.n + igp.m*igp.m + igp.n*igp.n)
+	assertFaceCount(chamfered, 10*T+2, t)
+	assertVertexCount(chamfered, 20*T, t)
+	assertEdgeCount(chamfered, 30*T, t)
+	assertVertexDegrees(chamfered, t)
+	assertOutwardWinding(&chamfered.Polyhedron, t)
+	assertNoIntegrityErrors(chamfered, t)
+
+	if len(mapping.Children) != len(igp.Faces()) || len(mapping.Parents) != len(chamfered.Faces()) {
+		t.Fatalf("Mapping has %v children and %v parents", len(mapping.Children), len(mapping.Parents))
+	}
+	edgeLength := igp.EdgeLength(igp.Edges()[0])
+	for i, f := range igp.Faces() {
+		child := mapping.Children[i]
+		if len(child.Loop()) != len(f.Loop()) {
+			t.Errorf("Face with %v sides has a child with %v sides", len(f.Loop()), len(child.Loop()))
+		}
+		if chamfered.FaceCenter(child).VectorTo(igp.FaceCenter(f)).Length() > edgeLength {
+			t.Errorf("Child of face %v lies at %v instead of %v", i, chamfered.FaceCenter(child), igp.FaceCenter(f))
+		}
+	}
+	for i, f := range chamfered.Faces() {
+		parents := mapping.Parents[i]
+		if len(parents) == 1 {
+			if !mapping.Children[indexOfFace(igp.Faces(), parents[0])].Equals(f) {
+				t.Errorf("Face %v is not the child of its parent", i)
+			}
+			continue
+		}
+		if len(parents) != 2 || len(f.Loop()) != 6 {
+			t.Errorf("Face %v with %v sides has %v parents", i, len(f.Loop()), len(parents))
+			continue
+		}
+		// The hexagon replaces the Edge between its parents.
+		shared := make([]Vertex, 0, 2)
+		for _, v := range parents[0].Loop() {
+			if containsVertex(parents[1].Loop(), v) {
+				shared = append(shared, v)
+			}
+		}
+		if len(shared) != 2 {
+			t.Errorf("Parents of hexagon %v do not share an edge", i)
+			continue
+		}
+		if d := r3.Distance(chamfered.FaceCenter(f), igp.EdgeCenter(NewEdge(shared[0], shared[1]))); d > edgeLength/2 {
+			t.Errorf("Hexagon %v lies %v away from the edge between its parents", i, d)
+		}
+	}
+}
+
+func indexOfFace(faces []Face, f Face) int {
+	for i, g := range faces {
+		if g.Equals(f) {
+			return i
+		}
+	}
+	return -1
+}
+
+func containsVertex(loop []Vertex, v Vertex) bool {
+	for _, w := range loop {
+		if w == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,334 @@
+package polyhedra
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// The Goldberg-Coxeter construction GC(m,n) places the corners of every Face of a triangulated or quadrangulated
+// Polyhedron on points of a triangular or square lattice and replaces the Face by the cells of the lattice. Like the
+// lattice subdivision of Geodesic.Subdivide, points that lie beyond an Edge are resolved by unfolding the neighbouring
+// Face into the plane of the current one. The faces are visited in their own winding, so the Polyhedron does not need
+// to be convex. 3-regular and 4-regular polyhedra are handled through their duals.
+
+// gcLattice is the lattice that the faces of a triangulated or quadrangulated Polyhedron are placed on. Points are
+// given by integer coordinates relative to the corners of a Face, which depend on the lattice and are scaled by
+// scale(m,n).
+type gcLattice interface {
+	// sides returns the number of sides of the faces and cells.
+	sides() int
+	// cells returns the cells of the lattice around a Face with the breakdown structure (m,n).
+	cells(m, n int) [][]latticePoint
+	// coordinates returns the coordinates of the lattice point.
+	coordinates(p latticePoint, m, n int) []int
+	scale(m, n int) int
+	// beyond returns the index i of an Edge from corner i to corner i+1 that the point lies beyond, or -1 if the
+	// point lies within the Face.
+	beyond(c []int, scale int) int
+	// on returns the index i of an Edge from corner i to corner i+1 that the point lies on, or -1 if there is none.
+	on(c []int, scale int) int
+	// unfold returns the coordinates of a point that lies beyond the i-th Edge relative to the neighbouring Face,
+	// whose corners start with the second corner of the Edge followed by the first one.
+	unfold(c []int, i, scale int) []int
+	// weights returns the non-negative weights of the corners for a point within the Face. They sum up to a value
+	// that only depends on (m,n).
+	weights(c []int, scale int) []int
+}
+
+// triangularLattice places the corners of every triangle on the points (0,0), (m,n) and (-n,m+n) of the lattice
+// that Geodesic.Subdivide uses. The coordinates are the barycentric weights of the corners.
+type triangularLattice struct{}
+
+func (triangularLattice) sides() int { return 3 }
+
+func (triangularLattice) cells(m, n int) [][]latticePoint {
+	cells := make([][]latticePoint, 0)
+	for x := -n - 1; x <= m+1; x++ {
+		for y := -1; y <= m+n+1; y++ {
+			cells = append(cells, []latticePoint{{x, y}, {x + 1, y}, {x, y + 1}},
+				[]latticePoint{{x + 1, y}, {x + 1, y + 1}, {x, y + 1}})
+		}
+	}
+	return cells
+}
+
+func (triangularLattice) coordinates(p latticePoint, m, n int) []int {
+	w := latticeWeights(p, m, n)
+	return w[:]
+}
+
+func (triangularLattice) scale(m, n int) int { return m*m + m*n + n*n }
+
+func (triangularLattice) beyond(c []int, _ int) int {
+	for i := range c {
+		if c[(i+2)%3] < 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+func (triangularLattice) on(c []int, _ int) int {
+	for i := range c {
+		if c[(i+2)%3] == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+func (triangularLattice) unfold(c []int, i, _ int) []int {
+	// The unfolded third corner of the neighbour is the sum of the corners of the Edge minus the opposite corner.
+	a, b, k := c[i], c[(i+1)%3], c[(i+2)%3]
+	return []int{b + k, a + k, -k}
+}
+
+func (triangularLattice) weights(c []int, _ int) []int { return c }
+
+// squareLattice places the corners of every quadrilateral on the points (0,0), (m,n), (m-n,m+n) and (-n,m) of the
+// square lattice. The coordinates (s,t) run along the edges from the first corner to the second and fourth corner,
+// the weights are those of bilinear interpolation between the corners.
+type squareLattice struct{}
+
+func (squareLattice) sides() int { return 4 }
+
+func (squareLattice) cells(m, n int) [][]latticePoint {
+	cells := make([][]latticePoint, 0)
+	for x := -n - 1; x <= m+1; x++ {
+		for y := -1; y <= m+n+1; y++ {
+			cells = append(cells, []latticePoint{{x, y}, {x + 1, y}, {x + 1, y + 1}, {x, y + 1}})
+		}
+	}
+	return cells
+}
+
+func (squareLattice) coordinates(p latticePoint, m, n int) []int {
+	return []int{p.x*m + p.y*n, p.y*m - p.x*n}
+}
+
+func (squareLattice) scale(m, n int) int { return m*m + n*n }
+
+func (squareLattice) beyond(c []int, scale int) int {
+	switch s, t := c[0], c[1]; {
+	case t < 0:
+		return 0
+	case s > scale:
+		return 1
+	case t > scale:
+		return 2
+	case s < 0:
+		return 3
+	}
+	return -1
+}
+
+func (squareLattice) on(c []int, scale int) int {
+	switch s, t := c[0], c[1]; {
+	case t == 0:
+		return 0
+	case s == scale:
+		return 1
+	case t == scale:
+		return 2
+	case s == 0:
+		return 3
+	}
+	return -1
+}
+
+func (squareLattice) unfold(c []int, i, scale int) []int {
+	s, t := c[0], c[1]
+	// Rotate the coordinates until the Edge lies at t=0, then mirror them into the neighbour.
+	for ; i > 0; i-- {
+		s, t = t, scale-s
+	}
+	return []int{scale - s, -t}
+}
+
+func (squareLattice) weights(c []int, scale int) []int {
+	s, t := c[0], c[1]
+	return []int{(scale - s) * (scale - t), s * (scale - t), s * t, (scale - s) * t}
+}
+
+// GoldbergCoxeter applies the Goldberg-Coxeter construction GC(m,n) with m >= 1 and n >= 0 to the given Polyhedron
+// and returns the result. For a triangulated Polyhedron this is the same as Geodesic.Subdivide, but it works with
+// any triangulated seed, for example an octahedron, a tetrahedron or an arbitrary mesh. Quadrangulated polyhedra are
+// subdivided along a square lattice into m*m+n*n quadrilaterals per Face instead. Polyhedra whose vertices all have
+// degree 3 or 4 are handled through their duals, which for example turns a dodecahedron into a GoldbergPolyhedron and
+// a cube into a cage of squares, hexagons and octagons.
+//
+// The options of Geodesic.Subdivide apply as well. Faces of quadrangulated polyhedra are partitioned by
+// EqualArcPartition if KennerPartition or MappedPartition is selected.
+// The Polyhedron needs to be a closed manifold with consistently wound faces, see Dual.
+func GoldbergCoxeter(p Interface, m, n int, options ...SubdivisionOption) (*Polyhedron, error) {
+	config := subdivisionConfig{handedness: RightHanded, projection: PlanarProjection, partition: EqualChordPartition}
+	for _, option := range options {
+		option(&config)
+	}
+
+	if m < 1 || n < 0 {
+		return nil, errors.New("breakdown requires m >= 1 and n >= 0")
+	}
+	if n != 0 && m != n && config.handedness == LeftHanded {
+		m, n = n, m
+	}
+
+	switch {
+	case allFacesHaveSides(p, 3):
+		return goldbergCoxeter(p, triangularLattice{}, m, n, config)
+	case allFacesHaveSides(p, 4):
+		return goldbergCoxeter(p, squareLattice{}, m, n, config)
+	case allVerticesHaveDegree(p, 3):
+		return goldbergCoxeterOfDual(p, triangularLattice{}, m, n, config)
+	case allVerticesHaveDegree(p, 4):
+		return goldbergCoxeterOfDual(p, squareLattice{}, m, n, config)
+	}
+	return nil, errors.New("goldberg-coxeter construction requires only triangles, only quadrilaterals, " +
+		"only vertices of degree 3 or only vertices of degree 4")
+}
+
+// allFacesHaveSides returns whether all faces of the Polyhedron have the given number of sides.
+func allFacesHaveSides(p Interface, sides int) bool {
+	for _, f := range p.Faces() {
+		if len(f.Loop()) != sides {
+			return false
+		}
+	}
+	return true
+}
+
+// allVerticesHaveDegree returns whether all vertices of the Polyhedron have the given degree.
+func allVerticesHaveDegree(p Interface, degree int) bool {
+	for _, v := range p.Vertices() {
+		if p.VertexDegree(v) != degree {
+			return false
+		}
+	}
+	return true
+}
+
+// goldbergCoxeterOfDual applies the construction to the dual of the Polyhedron and returns the dual of the result.
+// Both duals place their vertices at the centroids of the faces.
+func goldbergCoxeterOfDual(p Interface, lattice gcLattice, m, n int, config subdivisionConfig) (*Polyhedron, error) {
+	d, err := dual(p, dualConfig{placement: CentroidPlacement})
+	if err != nil {
+		return nil, err
+	}
+	subdivided, err := goldbergCoxeter(&d, lattice, m, n, config)
+	if err != nil {
+		return nil, err
+	}
+	return Dual(subdivided)
+}
+
+// goldbergCoxeter replaces every Face of the Polyhedron by the cells of the lattice.
+func goldbergCoxeter(p Interface, lattice gcLattice, m, n int, config subdivisionConfig) (*Polyhedron, error) {
+	b, err := newConwayBuilder(p)
+	if err != nil {
+		return nil, err
+	}
+	if lattice.sides() == 4 && (config.partition == KennerPartition || config.partition == MappedPartition) {
+		config.partition = EqualArcPartition
+	}
+	radius := 0.0
+	for _, v := range p.Vertices() {
+		radius += r3.Distance(origin, p.VertexPosition(v)) / float64(len(p.Vertices()))
+	}
+	config.projection = config.projection.withBaseRadius(radius)
+
+	scale := lattice.scale(m, n)
+	keyToIndex := make(map[latticeKey]int)
+	// index returns the index of the vertex at the point with the given coordinates relative to the corners,
+	// creating it if necessary.
+	index := func(corners []Vertex, c []int) int {
+		for unfolded := 0; ; unfolded++ {
+			i := lattice.beyond(c, scale)
+			if i < 0 {
+				break
+			}
+			if unfolded == lattice.sides() {
+				panic("lattice point is not within the neighbourhood of the subdivided face")
+			}
+			a, next := corners[i], corners[(i+1)%len(corners)]
+			corners = startingAt(b.faces[b.faceOf[directedEdge{next, a}]].Loop(), next)
+			c = lattice.unfold(c, i, scale)
+		}
+
+		w := lattice.weights(c, scale)
+		weights := make([]latticeWeight, 0, len(w))
+		for i := range w {
+			if w[i] > 0 {
+				weights = append(weights, latticeWeight{corners[i], w[i]})
+			}
+		}
+		sort.Slice(weights, func(i, j int) bool { return weights[i].v < weights[j].v })
+		var key latticeKey
+		copy(key[:], weights)
+		if i, ok := keyToIndex[key]; ok {
+			return i
+		}
+
+		points := make([]r3.Point, len(weights))
+		ws := make([]float64, len(weights))
+		for i, lw := range weights {
+			points[i] = config.projection.project(p.VertexPosition(lw.v))
+			ws[i] = float64(lw.w)
+		}
+		keyToIndex[key] = len(b.positions)
+		b.positions = append(b.positions, config.position(points, ws))
+		return keyToIndex[key]
+	}
+
+	cells := lattice.cells(m, n)
+	for _, f := range b.faces {
+		corners := f.Loop()
+		for _, cell := range cells {
+			coordinates := make([][]int, len(cell))
+			for i, point := range cell {
+				coordinates[i] = lattice.coordinates(point, m, n)
+			}
+			if !ownsCell(lattice, corners, coordinates, scale) {
+				continue
+			}
+			loop := make([]int, len(cell))
+			for i := range cell {
+				loop[i] = index(corners, coordinates[i])
+			}
+			b.loops = append(b.loops, loop)
+		}
+	}
+	return b.build()
+}
+
+// ownsCell returns whether the cell with corners at the given coordinates belongs to the Face with the given corners.
+// This is the case if the center of the cell lies within the Face. Cells whose center lies on an Edge belong to the
+// Face that traverses the Edge from its lower to its higher vertex.
+func ownsCell(lattice gcLattice, corners []Vertex, coordinates [][]int, scale int) bool {
+	// The sum of the coordinates is the center of the cell scaled by the number of its corners.
+	center := make([]int, len(coordinates[0]))
+	for _, c := range coordinates {
+		for i := range center {
+			center[i] += c[i]
+		}
+	}
+	scale *= len(coordinates)
+	if lattice.beyond(center, scale) >= 0 {
+		return false
+	}
+	if i := lattice.on(center, scale); i >= 0 && corners[i] > corners[(i+1)%len(corners)] {
+		return false
+	}
+	return true
+}
+
+// startingAt returns the loop rotated such that it starts with the given vertex.
+func startingAt(loop []Vertex, v Vertex) []Vertex {
+	for i, w := range loop {
+		if w == v {
+			return append(append([]Vertex(nil), loop[i:]...), loop[:i]...)
+		}
+	}
+	panic("vertex is not part of the loop")
+}
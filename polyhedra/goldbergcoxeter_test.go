@@ -0,0 +1,84 @@
+package polyhedra
+
+import (
+	"testing"
+)
+
+func TestGoldbergCoxeterMatchesSubdivide(t *testing.T) {
+	for _, breakdown := range [][2]int{{1, 0}, {3, 0}, {2, 2}, {2, 1}, {1, 3}} {
+		m, n := breakdown[0], breakdown[1]
+		geodesic := NewIcosahedralGeodesic()
+		if err := geodesic.Subdivide(m, n, WithProjection(SphericalProjection)); err != nil {
+			t.Fatal(err)
+		}
+		p, err := GoldbergCoxeter(NewIcosahedron(), m, n, WithProjection(SphericalProjection))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertCongruent(p, geodesic, t)
+		assertOutwardWinding(p, t)
+	}
+}
+
+func TestGoldbergCoxeterCounts(t *testing.T) {
+	tests := []struct {
+		name                   string
+		seed                   *Polyhedron
+		m, n                   int
+		vertices, edges, faces int
+	}{
+		{"octahedron", NewOctahedron(1), 2, 1, 30, 84, 56},
+		{"tetrahedron", NewTetrahedron(1), 3, 0, 20, 54, 36},
+		{"cube", NewCube(1), 2, 1, 32, 60, 30},
+		{"cube", NewCube(1), 2, 0, 26, 48, 24},
+		{"dodecahedron", NewDodecahedron(1), 1, 1, 60, 90, 32},
+		{"dodecahedron", NewDodecahedron(1), 2, 1, 140, 210, 72},
+		{"cuboctahedron", NewCuboctahedron(1), 1, 1, 24, 48, 26},
+		{"truncated octahedron", NewTruncatedOctahedron(1), 2, 0, 96, 144, 50},
+	}
+	for _, test := range tests {
+		p, err := GoldbergCoxeter(test.seed, test.m, test.n, WithProjection(SphericalProjection))
+		if err != nil {
+			t.Errorf("GC(%v,%v) of %v: %v", test.m, test.n, test.name, err)
+			continue
+		}
+		if len(p.Vertices()) != test.vertices || len(p.Edges()) != test.edges || len(p.Faces()) != test.faces {
+			t.Errorf("GC(%v,%v) of %v has %v vertices, %v edges and %v faces, expected %v, %v and %v", test.m,
+				test.n, test.name, len(p.Vertices()), len(p.Edges()), len(p.Faces()), test.vertices, test.edges,
+				test.faces)
+		}
+		assertOutwardWinding(p, t)
+	}
+}
+
+func TestGoldbergCoxeterOfArbitraryMesh(t *testing.T) {
+	seed, err := Kis(johnsonSolid(3, t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := GoldbergCoxeter(seed, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Faces()) != 7*len(seed.Faces()) {
+		t.Errorf("GC(2,1) has %v faces, expected %v", len(p.Faces()), 7*len(seed.Faces()))
+	}
+	if issues := NewIntegrityChecker().CheckIntegrity(p); len(issues) != 0 {
+		t.Errorf("GC(2,1) has integrity issues: %v", issues)
+	}
+
+	left, err := GoldbergCoxeter(seed, 1, 2, WithHandedness(LeftHanded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCongruent(left, p, t)
+}
+
+func TestGoldbergCoxeterRejectsMixedPolyhedra(t *testing.T) {
+	if _, err := GoldbergCoxeter(johnsonSolid(8, t), 2, 0); err == nil {
+		t.Error("Expected an error for a polyhedron with mixed faces and vertex degrees")
+	}
+	if _, err := GoldbergCoxeter(NewCube(1), 0, 1); err == nil {
+		t.Error("Expected an error for an invalid breakdown")
+	}
+}
@@ -0,0 +1,130 @@
+package polyhedra
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// hullFace is a face of a convex hull given as the indices of the points on it.
+type hullFace struct {
+	loop   []int
+	normal r3.Vector
+}
+
+// key returns a string that identifies the face independent of the order of its loop.
+func (f hullFace) key() string {
+	indices := append([]int(nil), f.loop...)
+	sort.Ints(indices)
+	return fmt.Sprint(indices)
+}
+
+// convexHullLoops returns the faces of the convex hull of the given points as loops of indices into the points.
+// The loops are wound counter clockwise when seen from outside. Every point needs to be a vertex of the hull, points
+// that lie within the hull or in the interior of one of its faces or edges end up in loops nonetheless.
+func convexHullLoops(points []r3.Point) [][]int {
+	centroid := r3.Centroid3D(points)
+	scale := 0.0
+	for _, p := range points {
+		scale = math.Max(scale, r3.Distance(centroid, p))
+	}
+	tolerance := 1e-9 * scale
+
+	// The point with the lowest x coordinate is always a vertex of the hull, so one of the faces of the hull contains it.
+	first := 0
+	for i, p := range points {
+		if p.X < points[first].X {
+			first = i
+		}
+	}
+	var start hullFace
+	found := false
+	for b := 0; b < len(points) && !found; b++ {
+		for c := 0; c < len(points) && !found; c++ {
+			start, found = supportingFace(points, first, b, c, tolerance)
+		}
+	}
+	if !found {
+		panic("convex hull requires points that are not coplanar")
+	}
+
+	// Walk across the edges of the known faces to find their neighbours.
+	faces := []hullFace{start}
+	known := map[string]bool{start.key(): true}
+	for i := 0; i < len(faces); i++ {
+		face := faces[i]
+		for j, a := range face.loop {
+			b := face.loop[(j+1)%len(face.loop)]
+			for c := range points {
+				neighbour, ok := supportingFace(points, b, a, c, tolerance)
+				if !ok || neighbour.normal.Dot(face.normal) > 1-1e-12 {
+					continue
+				}
+				if key := neighbour.key(); !known[key] {
+					known[key] = true
+					faces = append(faces, neighbour)
+				}
+				break
+			}
+		}
+	}
+
+	loops := make([][]int, len(faces))
+	for i, f := range faces {
+		loops[i] = f.loop
+	}
+	return loops
+}
+
+// supportingFace returns the face of the convex hull that lies in the plane through the points a, b and c, if the
+// plane is a supporting plane of the points.
+func supportingFace(points []r3.Point, a, b, c int, tolerance float64) (hullFace, bool) {
+	pa := points[a]
+	normal := pa.VectorTo(points[b]).Cross(pa.VectorTo(points[c]))
+	if normal.Length() < tolerance*tolerance {
+		return hullFace{}, false
+	}
+	normal = normal.Normalised()
+
+	above, below := false, false
+	onPlane := make([]int, 0)
+	for i, p := range points {
+		d := normal.Dot(pa.VectorTo(p))
+		switch {
+		case d > tolerance:
+			above = true
+		case d < -tolerance:
+			below = true
+		default:
+			onPlane = append(onPlane, i)
+		}
+		if above && below {
+			return hullFace{}, false
+		}
+	}
+	if above {
+		normal = normal.Scale(-1)
+	}
+	return hullFace{sortedAround(points, onPlane, normal), normal}, true
+}
+
+// sortedAround sorts the indices of the given coplanar points counter clockwise around the given normal.
+func sortedAround(points []r3.Point, indices []int, normal r3.Vector) []int {
+	coplanar := make([]r3.Point, len(indices))
+	for i, index := range indices {
+		coplanar[i] = points[index]
+	}
+	c := r3.Centroid3D(coplanar)
+	u := c.VectorTo(points[indices[0]])
+	w := normal.Cross(u)
+	angles := make(map[int]float64, len(indices))
+	for _, index := range indices {
+		d := c.VectorTo(points[index])
+		angles[index] = math.Atan2(d.Dot(w), d.Dot(u))
+	}
+	sorted := append([]int(nil), indices...)
+	sort.Slice(sorted, func(i, j int) bool { return angles[sorted[i]] < angles[sorted[j]] })
+	return sorted
+}
@@ -0,0 +1,29 @@
+package polyhedra
+
+import (
+	"testing"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+func TestConvexHullLoops(t *testing.T) {
+	cube := NewCube(1)
+	points := make([]r3.Point, len(cube.Vertices()))
+	for i, v := range cube.Vertices() {
+		points[i] = cube.VertexPosition(v)
+	}
+	loops := convexHullLoops(points)
+	if len(loops) != 6 {
+		t.Fatalf("Found %v faces instead of 6", len(loops))
+	}
+	for _, loop := range loops {
+		if len(loop) != 4 {
+			t.Errorf("Face %v has %v vertices instead of 4", loop, len(loop))
+		}
+		a, b, c := points[loop[0]], points[loop[1]], points[loop[2]]
+		normal := a.VectorTo(b).Cross(a.VectorTo(c))
+		if normal.Dot(origin.VectorTo(a)) <= 0 {
+			t.Errorf("Face %v is not wound counter clockwise when seen from outside", loop)
+		}
+	}
+}
@@ -5,13 +5,19 @@ import (
 	"math"
 )
 
-// NewIcosahedron creates an icosahedron.
+// NewIcosahedron creates a regular icosahedron with a circumradius of 1 that is centered at the origin.
+// Its faces are wound counter clockwise when seen from outside.
 func NewIcosahedron() *Polyhedron {
-	ico := newIcosahedron()
+	return NewIcosahedronWithRadius(1)
+}
+
+// NewIcosahedronWithRadius creates a regular icosahedron with the given circumradius that is centered at the origin.
+func NewIcosahedronWithRadius(radius float64) *Polyhedron {
+	ico := newIcosahedron(radius)
 	return &ico
 }
 
-func newIcosahedron() Polyhedron {
+func newIcosahedron(radius float64) Polyhedron {
 
 	ico := Polyhedron{}
 	ico.init()
@@ -20,12 +26,14 @@ func newIcosahedron() Polyhedron {
 	c2 := math.Cos(1.0 * math.Pi / 5.0)
 	s1 := math.Sin(2.0 * math.Pi / 5.0)
 	s2 := math.Sin(4.0 * math.Pi / 5.0)
-	h := math.Sqrt(3) / 2
+	// The pentagons have a circumradius of 1, which puts them at a height of 1/2 and the poles at sqrt(5)/2.
+	h := 0.5
+	pole := math.Sqrt(5) / 2
 	vertexPos := []r3.Point{
 		// Top Vertex
-		{0, 0, math.Sqrt2 * h},
+		{0, 0, pole},
 		// Bottom Vertex
-		{0, 0, -math.Sqrt2 * h},
+		{0, 0, -pole},
 		// Top Pentagon
 		{0, -1, h},
 		{s1, -c1, h},
@@ -40,10 +48,9 @@ func newIcosahedron() Polyhedron {
 		{-s2, -c2, -h},
 	}
 
-	ico.vertices = make([]Vertex, 12)
-	for i := range ico.vertices {
-		ico.vertices[i] = NewVertex()
-		ico.vertices[i].setPosition(vertexPos[i])
+	// Scale the icosahedron to the requested circumradius.
+	for _, pos := range vertexPos {
+		ico.newVertex(onSphere(pos, radius))
 	}
 
 	topVertex := ico.vertices[0]
@@ -52,7 +59,8 @@ func newIcosahedron() Polyhedron {
 	topPentagon := ico.vertices[2 : 2+5]
 	bottomPentagon := ico.vertices[7 : 7+5]
 
-	connectPoles := func(pentagon []Vertex, poleVertex Vertex) {
+	// All faces are wound counter clockwise when seen from outside.
+	connectPoles := func(pentagon []Vertex, poleVertex Vertex, top bool) {
 		for i, vertex := range pentagon {
 			neighborLIndex := (5 + i - 1) % 5
 			neighborL := pentagon[neighborLIndex]
@@ -63,12 +71,16 @@ func newIcosahedron() Polyhedron {
 				panic("Added illegal edge.")
 			}
 
-			ico.addFaceFromLoop([]Vertex{vertex, neighborL, poleVertex})
+			if top {
+				ico.addFaceFromLoop([]Vertex{neighborL, vertex, poleVertex})
+			} else {
+				ico.addFaceFromLoop([]Vertex{vertex, neighborL, poleVertex})
+			}
 		}
 	}
 	// Connect bottom and top poles
-	connectPoles(topPentagon, topVertex)
-	connectPoles(bottomPentagon, bottomVertex)
+	connectPoles(topPentagon, topVertex, true)
+	connectPoles(bottomPentagon, bottomVertex, false)
 
 	// Connect bottom pentagon
 	for i, vertex := range bottomPentagon {
@@ -91,5 +103,6 @@ func newIcosahedron() Polyhedron {
 		ico.addEdge(vertex, bottomNeighbor)
 		ico.addFaceFromLoop([]Vertex{vertex, topNeighbor, bottomNeighbor})
 	}
+	ico.updateEdgeCache()
 	return ico
 }
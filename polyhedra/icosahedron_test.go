@@ -5,9 +5,9 @@ import (
 )
 
 func TestIcosahedronCreation(t *testing.T) {
-	ico := newIcosahedron()
+	ico := newIcosahedron(1)
 
-	errors := IcosahedralGeodesicIntegrityChecker(IcosahedralGeodesic(Geodesic{ico, 1, 0})).CheckIntegrity()
+	errors := IcosahedralGeodesicIntegrityChecker(IcosahedralGeodesic(Geodesic{ico, 1, 0, icosahedronBase, PlanarProjection})).CheckIntegrity()
 	if len(errors) != 0 {
 		t.Fatalf("Geodesic is in illegal state: %v ", errors)
 	}
@@ -41,6 +41,14 @@ func TestIcosahedronCreation(t *testing.T) {
 	}
 }
 
+func TestIcosahedronWinding(t *testing.T) {
+	ico := NewIcosahedron()
+	if violations := WindingCheck().Run(ico); len(violations) != 0 {
+		t.Errorf("Icosahedron is not wound consistently: %v", violations)
+	}
+	assertOutwardWinding(ico, t)
+}
+
 func TestIcosahedronEdgeOrder(t *testing.T) {
 	ico1 := NewIcosahedron()
 	ico2 := NewIcosahedron()
@@ -52,7 +60,7 @@ func TestIcosahedronEdgeOrder(t *testing.T) {
 		e1 := e1S[i]
 		e2 := e2S[i]
 
-		d := e1.Center().VectorTo(e2.Center()).Length()
+		d := ico1.EdgeCenter(e1).VectorTo(ico2.EdgeCenter(e2)).Length()
 		if d > epsilon {
 			t.Log(d)
 			t.Errorf("Expected egde order to be the same but vertex %v is %v and %v.", i, e1, e2)
@@ -0,0 +1,299 @@
+package polyhedra
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// Severity describes how serious an Issue found by an IntegrityChecker is.
+type Severity int
+
+const (
+	// SeverityInfo marks issues that are noteworthy but do not indicate a problem.
+	SeverityInfo Severity = iota
+	// SeverityWarning marks issues that are not invalid in general but might be a problem for some applications.
+	SeverityWarning
+	// SeverityError marks issues that make the Polyhedron invalid.
+	SeverityError
+)
+
+// String returns the name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// Violation describes a single problem found by a Check.
+type Violation struct {
+	// Element is the Vertex, Edge or Face that violates the check, or nil if the violation concerns the whole
+	// Polyhedron.
+	Element interface{}
+	Message string
+}
+
+// Check is a named integrity check that can be run on any Polyhedron.
+type Check struct {
+	Name     string
+	Severity Severity
+	Run      func(p Interface) []Violation
+}
+
+// Issue is a Violation together with the Check that reported it.
+type Issue struct {
+	Check    string
+	Severity Severity
+	Violation
+}
+
+// String returns a human readable description of the issue.
+func (i Issue) String() string {
+	return fmt.Sprintf("%v: %v: %v", i.Severity, i.Check, i.Message)
+}
+
+// IntegrityChecker runs a configurable list of checks on a Polyhedron.
+// In contrast to IcosahedralGeodesicIntegrityChecker it does not make any assumptions about the structure of the
+// Polyhedron.
+type IntegrityChecker struct {
+	checks []Check
+}
+
+// NewIntegrityChecker creates an IntegrityChecker with the default checks for closed polyhedra of genus zero.
+// These are EulerCharacteristicCheck, ClosedCheck, ManifoldCheck, WindingCheck and PlanarFacesCheck.
+func NewIntegrityChecker() *IntegrityChecker {
+	checker := &IntegrityChecker{}
+	checker.Register(EulerCharacteristicCheck())
+	checker.Register(ClosedCheck())
+	checker.Register(ManifoldCheck())
+	checker.Register(WindingCheck())
+	checker.Register(PlanarFacesCheck(1e-6))
+	return checker
+}
+
+// Register adds the given check to the checker.
+func (c *IntegrityChecker) Register(check Check) {
+	c.checks = append(c.checks, check)
+}
+
+// Checks returns the names of all registered checks in the order they are run.
+func (c *IntegrityChecker) Checks() []string {
+	names := make([]string, len(c.checks))
+	for i, check := range c.checks {
+		names[i] = check.Name
+	}
+	return names
+}
+
+// CheckIntegrity runs all registered checks on the given Polyhedron and returns all issues that were found.
+// If no issue was found an empty slice is returned.
+func (c *IntegrityChecker) CheckIntegrity(p Interface) []Issue {
+	issues := make([]Issue, 0)
+	for _, check := range c.checks {
+		for _, violation := range check.Run(p) {
+			issues = append(issues, Issue{check.Name, check.Severity, violation})
+		}
+	}
+	return issues
+}
+
+// edgeFaceIndices maps each Edge to the indices of all faces that contain it.
+func edgeFaceIndices(faces []Face) map[Edge][]int {
+	edgeFaces := make(map[Edge][]int)
+	for i, f := range faces {
+		for _, e := range f.Edges() {
+			edgeFaces[e] = append(edgeFaces[e], i)
+		}
+	}
+	return edgeFaces
+}
+
+// EulerCharacteristicCheck checks that V-E+F=2, which holds for all closed polyhedra of genus zero.
+func EulerCharacteristicCheck() Check {
+	return Check{
+		Name:     "euler-characteristic",
+		Severity: SeverityError,
+		Run: func(p Interface) []Violation {
+			v, e, f := len(p.Vertices()), len(p.Edges()), len(p.Faces())
+			if chi := v - e + f; chi != 2 {
+				return []Violation{{nil, fmt.Sprintf("V-E+F is %v-%v+%v=%v instead of 2", v, e, f, chi)}}
+			}
+			return nil
+		},
+	}
+}
+
+// ClosedCheck checks that every Edge is part of at least two faces, so the surface has no holes.
+func ClosedCheck() Check {
+	return Check{
+		Name:     "closed",
+		Severity: SeverityError,
+		Run: func(p Interface) []Violation {
+			var violations []Violation
+			edgeFaces := edgeFaceIndices(p.Faces())
+			for _, e := range p.Edges() {
+				if n := len(edgeFaces[e]); n < 2 {
+					violations = append(violations, Violation{e, fmt.Sprintf("edge %v is part of %v faces", e, n)})
+				}
+			}
+			return violations
+		},
+	}
+}
+
+// ManifoldCheck checks that no Edge is part of more than two faces and that the faces around each Vertex form a
+// single fan.
+func ManifoldCheck() Check {
+	return Check{
+		Name:     "manifold",
+		Severity: SeverityError,
+		Run: func(p Interface) []Violation {
+			var violations []Violation
+			faces := p.Faces()
+			edgeFaces := edgeFaceIndices(faces)
+			for _, e := range p.Edges() {
+				if n := len(edgeFaces[e]); n > 2 {
+					violations = append(violations, Violation{e, fmt.Sprintf("edge %v is part of %v faces", e, n)})
+				}
+			}
+
+			vertexFaces := make(map[Vertex][]int)
+			for i, f := range faces {
+				for _, v := range f.Loop() {
+					vertexFaces[v] = append(vertexFaces[v], i)
+				}
+			}
+			for _, v := range p.Vertices() {
+				if fans := countFans(v, vertexFaces[v], faces, edgeFaces); fans > 1 {
+					violations = append(violations, Violation{v, fmt.Sprintf("faces around vertex %v form %v fans", v, fans)})
+				}
+			}
+			return violations
+		},
+	}
+}
+
+// countFans returns the number of groups of faces around the vertex that are connected through edges that contain
+// the vertex.
+func countFans(v Vertex, incident []int, faces []Face, edgeFaces map[Edge][]int) int {
+	visited := make(map[int]bool, len(incident))
+	fans := 0
+	for _, start := range incident {
+		if visited[start] {
+			continue
+		}
+		fans++
+		stack := []int{start}
+		visited[start] = true
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, e := range faces[current].Edges() {
+				if !e.Contains(v) {
+					continue
+				}
+				for _, next := range edgeFaces[e] {
+					if !visited[next] {
+						visited[next] = true
+						stack = append(stack, next)
+					}
+				}
+			}
+		}
+	}
+	return fans
+}
+
+// WindingCheck checks that all faces are wound consistently. This is the case if every pair of faces traverses
+// their shared Edge in opposite directions.
+func WindingCheck() Check {
+	return Check{
+		Name:     "winding",
+		Severity: SeverityError,
+		Run: func(p Interface) []Violation {
+			var violations []Violation
+			directed := make(map[Edge]bool)
+			for _, f := range p.Faces() {
+				loop := f.Loop()
+				for i := range loop {
+					e := Edge{loop[i], loop[(i+1)%len(loop)]}
+					if directed[e] {
+						violations = append(violations, Violation{
+							NewEdge(e.v1, e.v2),
+							fmt.Sprintf("edge %v is traversed in the same direction by more than one face", e),
+						})
+					}
+					directed[e] = true
+				}
+			}
+			return violations
+		},
+	}
+}
+
+// PlanarFacesCheck checks that the vertices of each Face lie in a common plane. The tolerance is relative to the
+// mean length of the edges of the Face.
+func PlanarFacesCheck(tolerance float64) Check {
+	return Check{
+		Name:     "planar-faces",
+		Severity: SeverityWarning,
+		Run: func(p Interface) []Violation {
+			var violations []Violation
+			for _, f := range p.Faces() {
+				if deviation := faceNonPlanarity(p, f); deviation > tolerance {
+					violations = append(violations, Violation{
+						f,
+						fmt.Sprintf("face %v deviates from its plane by %v", f.String(), deviation),
+					})
+				}
+			}
+			return violations
+		},
+	}
+}
+
+// faceNormal returns the unit normal of the Face computed with Newell's method. The normal points to the side from
+// which the Face is wound counter clockwise.
+func faceNormal(p Interface, f Face) r3.Vector {
+	var normal r3.Vector
+	loop := f.Loop()
+	for i := range loop {
+		a := p.VertexPosition(loop[i])
+		b := p.VertexPosition(loop[(i+1)%len(loop)])
+		normal.X += (a.Y - b.Y) * (a.Z + b.Z)
+		normal.Y += (a.Z - b.Z) * (a.X + b.X)
+		normal.Z += (a.X - b.X) * (a.Y + b.Y)
+	}
+	return normal.Normalised()
+}
+
+// faceNonPlanarity returns the largest distance of a vertex of the Face from the plane through the centroid of the
+// Face, relative to the mean edge length of the Face.
+func faceNonPlanarity(p Interface, f Face) float64 {
+	loop := f.Loop()
+	if len(loop) <= 3 {
+		return 0
+	}
+	positions := make([]r3.Point, len(loop))
+	perimeter := 0.0
+	for i, v := range loop {
+		positions[i] = p.VertexPosition(v)
+	}
+	for i := range positions {
+		perimeter += r3.Distance(positions[i], positions[(i+1)%len(positions)])
+	}
+	center := r3.Centroid3D(positions)
+	normal := faceNormal(p, f)
+	maxDistance := 0.0
+	for _, pos := range positions {
+		maxDistance = math.Max(maxDistance, math.Abs(center.VectorTo(pos).Dot(normal)))
+	}
+	return maxDistance / (perimeter / float64(len(loop)))
+}
@@ -0,0 +1,148 @@
+package polyhedra
+
+import (
+	"testing"
+)
+
+func assertNoIntegrityErrors(p Interface, t *testing.T) {
+	for _, issue := range NewIntegrityChecker().CheckIntegrity(p) {
+		if issue.Severity == SeverityError {
+			t.Errorf("Unexpected integrity issue: %v", issue)
+		}
+	}
+}
+
+// unvalidatedPolyhedron creates a Polyhedron from the given topology without validating it.
+func unvalidatedPolyhedron(vertices []Vertex, edges []Edge, faces []Face) *Polyhedron {
+	p := Polyhedron{}
+	p.init()
+	p.vertices = vertices
+	p.setFaces(faces)
+	p.setEdges(edges)
+	return &p
+}
+
+func issuesOf(issues []Issue, check string) []Issue {
+	var found []Issue
+	for _, issue := range issues {
+		if issue.Check == check {
+			found = append(found, issue)
+		}
+	}
+	return found
+}
+
+func TestIntegrityCheckerValidPolyhedra(t *testing.T) {
+	ico := NewIcosahedron()
+	issues := NewIntegrityChecker().CheckIntegrity(ico)
+	if len(issues) != 0 {
+		t.Errorf("Icosahedron has integrity issues: %v", issues)
+	}
+
+	for _, mn := range [][2]int{{3, 0}, {2, 2}, {2, 1}} {
+		gg := NewIcosahedralGeodesic()
+		if err := gg.Subdivide(mn[0], mn[1], WithProjection(SphericalProjection)); err != nil {
+			t.Fatalf("Legal subdivision failed: %v", err)
+		}
+		assertNoIntegrityErrors(gg, t)
+
+		gp, err := GeodesicToGoldberg(gg)
+		if err != nil {
+			t.Fatalf("Conversion to Goldberg polyhedron failed: %v", err)
+		}
+		assertNoIntegrityErrors(gp, t)
+	}
+
+	gp, _ := NewIcosahedralGoldbergPolyhedron(1, 0)
+	issues = NewIntegrityChecker().CheckIntegrity(gp)
+	if len(issues) != 0 {
+		t.Errorf("Dodecahedron has integrity issues: %v", issues)
+	}
+}
+
+func TestIntegrityCheckerOpenSurface(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	p := unvalidatedPolyhedron(vertices, edges, faces[1:])
+	issues := NewIntegrityChecker().CheckIntegrity(p)
+
+	if len(issuesOf(issues, "euler-characteristic")) != 1 {
+		t.Errorf("Expected an euler characteristic issue but got %v", issues)
+	}
+	closed := issuesOf(issues, "closed")
+	if len(closed) != 3 {
+		t.Fatalf("Expected 3 issues for open edges but got %v", closed)
+	}
+	for _, issue := range closed {
+		if _, ok := issue.Element.(Edge); !ok || issue.Severity != SeverityError {
+			t.Errorf("Expected an error for an edge but got %v", issue)
+		}
+	}
+}
+
+func TestIntegrityCheckerNonManifold(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	vertices = append(vertices, 5)
+	edges = append(edges, NewEdge(1, 5), NewEdge(2, 5), NewEdge(3, 5))
+	faces = append(faces, NewFace([]Vertex{1, 2, 5}), NewFace([]Vertex{2, 3, 5}), NewFace([]Vertex{3, 1, 5}))
+	p := unvalidatedPolyhedron(vertices, edges, faces)
+
+	manifold := issuesOf(NewIntegrityChecker().CheckIntegrity(p), "manifold")
+	if len(manifold) == 0 {
+		t.Fatal("Expected manifold issues")
+	}
+}
+
+func TestIntegrityCheckerWinding(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	faces[0] = NewFace([]Vertex{3, 2, 1})
+	p := unvalidatedPolyhedron(vertices, edges, faces)
+
+	winding := issuesOf(NewIntegrityChecker().CheckIntegrity(p), "winding")
+	if len(winding) != 3 {
+		t.Errorf("Expected 3 winding issues but got %v", winding)
+	}
+}
+
+func TestIntegrityCheckerPlanarFaces(t *testing.T) {
+	gp, _ := NewIcosahedralGoldbergPolyhedron(1, 0)
+	f := gp.Faces()[0]
+	v := f.Loop()[0]
+	gp.SetVertexPosition(v, gp.VertexPosition(v).Add(origin.VectorTo(gp.VertexPosition(v)).Scale(0.1)))
+
+	planar := issuesOf(NewIntegrityChecker().CheckIntegrity(gp), "planar-faces")
+	if len(planar) != 3 {
+		t.Fatalf("Expected 3 non planar faces but got %v", planar)
+	}
+	for _, issue := range planar {
+		if issue.Severity != SeverityWarning {
+			t.Errorf("Expected a warning but got %v", issue)
+		}
+	}
+}
+
+func TestIntegrityCheckerCustomCheck(t *testing.T) {
+	checker := &IntegrityChecker{}
+	checker.Register(Check{
+		Name:     "only-triangles",
+		Severity: SeverityInfo,
+		Run: func(p Interface) []Violation {
+			var violations []Violation
+			for _, f := range p.Faces() {
+				if len(f.Loop()) != 3 {
+					violations = append(violations, Violation{f, "face is not a triangle"})
+				}
+			}
+			return violations
+		},
+	})
+	if names := checker.Checks(); len(names) != 1 || names[0] != "only-triangles" {
+		t.Errorf("Unexpected registered checks %v", names)
+	}
+	if issues := checker.CheckIntegrity(NewIcosahedron()); len(issues) != 0 {
+		t.Errorf("Icosahedron has unexpected issues: %v", issues)
+	}
+	gp, _ := NewIcosahedralGoldbergPolyhedron(1, 0)
+	if issues := checker.CheckIntegrity(gp); len(issues) != 12 {
+		t.Errorf("Expected 12 issues for the dodecahedron but got %v", len(issues))
+	}
+}
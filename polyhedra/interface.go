@@ -1,11 +1,17 @@
 package polyhedra
 
+import (
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
 // Interface represents the functionality provided by a Polyhedron.
 type Interface interface {
 	Vertices() []Vertex
 	Edges() []Edge
 	Faces() []Face
 
+	VertexPosition(v Vertex) r3.Point
+
 	VertexDegree(vertex Vertex) int
 	AdjacentVertices(vertex Vertex) []Vertex
 
@@ -0,0 +1,587 @@
+package polyhedra
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// johnsonNames contains the names of the Johnson solids J1 to J92 in the order of their numbers.
+var johnsonNames = [...]string{
+	"square pyramid",
+	"pentagonal pyramid",
+	"triangular cupola",
+	"square cupola",
+	"pentagonal cupola",
+	"pentagonal rotunda",
+	"elongated triangular pyramid",
+	"elongated square pyramid",
+	"elongated pentagonal pyramid",
+	"gyroelongated square pyramid",
+	"gyroelongated pentagonal pyramid",
+	"triangular bipyramid",
+	"pentagonal bipyramid",
+	"elongated triangular bipyramid",
+	"elongated square bipyramid",
+	"elongated pentagonal bipyramid",
+	"gyroelongated square bipyramid",
+	"elongated triangular cupola",
+	"elongated square cupola",
+	"elongated pentagonal cupola",
+	"elongated pentagonal rotunda",
+	"gyroelongated triangular cupola",
+	"gyroelongated square cupola",
+	"gyroelongated pentagonal cupola",
+	"gyroelongated pentagonal rotunda",
+	"gyrobifastigium",
+	"triangular orthobicupola",
+	"square orthobicupola",
+	"square gyrobicupola",
+	"pentagonal orthobicupola",
+	"pentagonal gyrobicupola",
+	"pentagonal orthocupolarotunda",
+	"pentagonal gyrocupolarotunda",
+	"pentagonal orthobirotunda",
+	"elongated triangular orthobicupola",
+	"elongated triangular gyrobicupola",
+	"elongated square gyrobicupola",
+	"elongated pentagonal orthobicupola",
+	"elongated pentagonal gyrobicupola",
+	"elongated pentagonal orthocupolarotunda",
+	"elongated pentagonal gyrocupolarotunda",
+	"elongated pentagonal orthobirotunda",
+	"elongated pentagonal gyrobirotunda",
+	"gyroelongated triangular bicupola",
+	"gyroelongated square bicupola",
+	"gyroelongated pentagonal bicupola",
+	"gyroelongated pentagonal cupolarotunda",
+	"gyroelongated pentagonal birotunda",
+	"augmented triangular prism",
+	"biaugmented triangular prism",
+	"triaugmented triangular prism",
+	"augmented pentagonal prism",
+	"biaugmented pentagonal prism",
+	"augmented hexagonal prism",
+	"parabiaugmented hexagonal prism",
+	"metabiaugmented hexagonal prism",
+	"triaugmented hexagonal prism",
+	"augmented dodecahedron",
+	"parabiaugmented dodecahedron",
+	"metabiaugmented dodecahedron",
+	"triaugmented dodecahedron",
+	"metabidiminished icosahedron",
+	"tridiminished icosahedron",
+	"augmented tridiminished icosahedron",
+	"augmented truncated tetrahedron",
+	"augmented truncated cube",
+	"biaugmented truncated cube",
+	"augmented truncated dodecahedron",
+	"parabiaugmented truncated dodecahedron",
+	"metabiaugmented truncated dodecahedron",
+	"triaugmented truncated dodecahedron",
+	"gyrate rhombicosidodecahedron",
+	"parabigyrate rhombicosidodecahedron",
+	"metabigyrate rhombicosidodecahedron",
+	"trigyrate rhombicosidodecahedron",
+	"diminished rhombicosidodecahedron",
+	"paragyrate diminished rhombicosidodecahedron",
+	"metagyrate diminished rhombicosidodecahedron",
+	"bigyrate diminished rhombicosidodecahedron",
+	"parabidiminished rhombicosidodecahedron",
+	"metabidiminished rhombicosidodecahedron",
+	"gyrate bidiminished rhombicosidodecahedron",
+	"tridiminished rhombicosidodecahedron",
+	"snub disphenoid",
+	"snub square antiprism",
+	"sphenocorona",
+	"augmented sphenocorona",
+	"sphenomegacorona",
+	"hebesphenomegacorona",
+	"disphenocingulum",
+	"bilunabirotunda",
+	"triangular hebesphenorotunda",
+}
+
+// JohnsonSolidCount is the number of Johnson solids.
+const JohnsonSolidCount = len(johnsonNames)
+
+// JohnsonSolidName returns the name of the Johnson solid Jk, for example "square pyramid" for k=1.
+// It returns an empty string if there is no Johnson solid with that number.
+func JohnsonSolidName(k int) string {
+	if k < 1 || k > JohnsonSolidCount {
+		return ""
+	}
+	return johnsonNames[k-1]
+}
+
+// LookupJohnsonSolid returns the number of the Johnson solid with the given name. Case and surrounding whitespace of
+// the name are ignored. The second return value is false if there is no Johnson solid with that name.
+func LookupJohnsonSolid(name string) (int, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for i, n := range johnsonNames {
+		if n == name {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// NewJohnsonSolid creates the Johnson solid Jk for 1 <= k <= 92. All edges have unit length, the centroid of the
+// vertices lies at the origin and the faces are wound counter clockwise when seen from outside.
+// It returns an error if there is no Johnson solid with the number k.
+func NewJohnsonSolid(k int) (*Polyhedron, error) {
+	if k < 1 || k > JohnsonSolidCount {
+		return nil, fmt.Errorf("there is no Johnson solid J%v", k)
+	}
+	points, err := johnsonPoints(k)
+	if err != nil {
+		return nil, err
+	}
+	return newCenteredPolyhedron(points, convexHullLoops(points)), nil
+}
+
+// johnsonPoints returns the vertex positions of the Johnson solid Jk.
+func johnsonPoints(k int) ([]r3.Point, error) {
+	switch {
+	case k <= 25 || (k >= 27 && k <= 48):
+		return axialJohnsonPoints(k), nil
+	case k == 26:
+		h := math.Sqrt(3) / 2
+		return []r3.Point{
+			{X: 0.5, Y: 0.5, Z: 0}, {X: -0.5, Y: 0.5, Z: 0}, {X: -0.5, Y: -0.5, Z: 0}, {X: 0.5, Y: -0.5, Z: 0},
+			{X: 0, Y: 0.5, Z: h}, {X: 0, Y: -0.5, Z: h}, {X: 0.5, Y: 0, Z: -h}, {X: -0.5, Y: 0, Z: -h},
+		}, nil
+	case k <= 57:
+		return augmentedPrismPoints(k), nil
+	case k <= 64:
+		return modifiedPlatonicPoints(k)
+	case k <= 71:
+		return augmentedTruncatedPoints(k), nil
+	case k <= 83:
+		return modifiedRhombicosidodecahedronPoints(k), nil
+	}
+	return irregularJohnsonPoints(k), nil
+}
+
+// axialJohnsonPoints returns the vertex positions of the Johnson solids that are stacks along the z axis.
+func axialJohnsonPoints(k int) []r3.Point {
+	type stack struct {
+		bottom capKind
+		middle middleKind
+		top    capKind
+		ring   int
+		gyro   bool
+	}
+	stacks := map[int]stack{
+		1:  {noCap, noMiddle, pyramidCap, 4, false},
+		2:  {noCap, noMiddle, pyramidCap, 5, false},
+		3:  {noCap, noMiddle, cupolaCap, 6, false},
+		4:  {noCap, noMiddle, cupolaCap, 8, false},
+		5:  {noCap, noMiddle, cupolaCap, 10, false},
+		6:  {noCap, noMiddle, rotundaCap, 10, false},
+		7:  {noCap, prismMiddle, pyramidCap, 3, false},
+		8:  {noCap, prismMiddle, pyramidCap, 4, false},
+		9:  {noCap, prismMiddle, pyramidCap, 5, false},
+		10: {noCap, antiprismMiddle, pyramidCap, 4, false},
+		11: {noCap, antiprismMiddle, pyramidCap, 5, false},
+		12: {pyramidCap, noMiddle, pyramidCap, 3, false},
+		13: {pyramidCap, noMiddle, pyramidCap, 5, false},
+		14: {pyramidCap, prismMiddle, pyramidCap, 3, false},
+		15: {pyramidCap, prismMiddle, pyramidCap, 4, false},
+		16: {pyramidCap, prismMiddle, pyramidCap, 5, false},
+		17: {pyramidCap, antiprismMiddle, pyramidCap, 4, false},
+		18: {noCap, prismMiddle, cupolaCap, 6, false},
+		19: {noCap, prismMiddle, cupolaCap, 8, false},
+		20: {noCap, prismMiddle, cupolaCap, 10, false},
+		21: {noCap, prismMiddle, rotundaCap, 10, false},
+		22: {noCap, antiprismMiddle, cupolaCap, 6, false},
+		23: {noCap, antiprismMiddle, cupolaCap, 8, false},
+		24: {noCap, antiprismMiddle, cupolaCap, 10, false},
+		25: {noCap, antiprismMiddle, rotundaCap, 10, false},
+		27: {cupolaCap, noMiddle, cupolaCap, 6, false},
+		28: {cupolaCap, noMiddle, cupolaCap, 8, false},
+		29: {cupolaCap, noMiddle, cupolaCap, 8, true},
+		30: {cupolaCap, noMiddle, cupolaCap, 10, false},
+		31: {cupolaCap, noMiddle, cupolaCap, 10, true},
+		32: {cupolaCap, noMiddle, rotundaCap, 10, false},
+		33: {cupolaCap, noMiddle, rotundaCap, 10, true},
+		34: {rotundaCap, noMiddle, rotundaCap, 10, false},
+		35: {cupolaCap, prismMiddle, cupolaCap, 6, false},
+		36: {cupolaCap, prismMiddle, cupolaCap, 6, true},
+		37: {cupolaCap, prismMiddle, cupolaCap, 8, true},
+		38: {cupolaCap, prismMiddle, cupolaCap, 10, false},
+		39: {cupolaCap, prismMiddle, cupolaCap, 10, true},
+		40: {cupolaCap, prismMiddle, rotundaCap, 10, false},
+		41: {cupolaCap, prismMiddle, rotundaCap, 10, true},
+		42: {rotundaCap, prismMiddle, rotundaCap, 10, false},
+		43: {rotundaCap, prismMiddle, rotundaCap, 10, true},
+		44: {cupolaCap, antiprismMiddle, cupolaCap, 6, false},
+		45: {cupolaCap, antiprismMiddle, cupolaCap, 8, false},
+		46: {cupolaCap, antiprismMiddle, cupolaCap, 10, false},
+		47: {cupolaCap, antiprismMiddle, rotundaCap, 10, false},
+		48: {rotundaCap, antiprismMiddle, rotundaCap, 10, false},
+	}
+	s := stacks[k]
+	return axialSolid(s.bottom, s.middle, s.top, s.ring, s.gyro)
+}
+
+// augmentedPrismPoints returns the vertex positions of the prisms with square pyramids on some of their sides.
+func augmentedPrismPoints(k int) []r3.Point {
+	sides := map[int]struct {
+		n     int
+		faces []int
+	}{
+		49: {3, []int{0}},
+		50: {3, []int{0, 1}},
+		51: {3, []int{0, 1, 2}},
+		52: {5, []int{0}},
+		53: {5, []int{0, 2}},
+		54: {6, []int{0}},
+		55: {6, []int{0, 3}},
+		56: {6, []int{0, 2}},
+		57: {6, []int{0, 2, 4}},
+	}[k]
+	points := unitEdgePoints(newPrism(sides.n))
+	loops, centers, normals := hullOf(points)
+	squares := facesWithSides(loops, 4)
+	// Sort the squares around the axis of the prism, so that neighbouring squares have neighbouring indices.
+	around := make([]int, len(squares))
+	for _, s := range squares {
+		angle := math.Atan2(normals[s].Y, normals[s].X)
+		if angle < 0 {
+			angle += 2 * math.Pi
+		}
+		around[int(math.Floor(angle/(2*math.Pi)*float64(sides.n)))%sides.n] = s
+	}
+	faces := make([]int, len(sides.faces))
+	for i, f := range sides.faces {
+		faces[i] = around[f]
+	}
+	return augmented(points, faces, loops, centers, normals)
+}
+
+// metaAngle is the angle between two faces of a dodecahedron that are neither neighbours nor opposite.
+var metaAngle = math.Acos(-1 / math.Sqrt(5))
+
+// modifiedPlatonicPoints returns the vertex positions of the augmented dodecahedra and the diminished icosahedra.
+func modifiedPlatonicPoints(k int) ([]r3.Point, error) {
+	if k <= 61 {
+		points := unitEdgePoints(NewDodecahedron(1))
+		loops, centers, normals := hullOf(points)
+		pentagons := facesWithSides(loops, 5)
+		faces := map[int][]int{
+			58: pentagons[:1],
+			59: spreadFaces(normals, pentagons, 2, math.Pi),
+			60: spreadFaces(normals, pentagons, 2, metaAngle),
+			61: spreadFaces(normals, pentagons, 3, metaAngle),
+		}[k]
+		return augmented(points, faces, loops, centers, normals), nil
+	}
+
+	points := unitEdgePoints(NewIcosahedron())
+	directions := make([]r3.Vector, len(points))
+	indices := make([]int, len(points))
+	for i, p := range points {
+		directions[i] = origin.VectorTo(p).Normalised()
+		indices[i] = i
+	}
+	count := 2
+	if k > 62 {
+		count = 3
+	}
+	points = withoutIndices(points, spreadFaces(directions, indices, count, metaAngle))
+	if k < 64 {
+		return points, nil
+	}
+	// The tetrahedron goes onto the triangle that is surrounded by the three pentagons.
+	loops, centers, normals := hullOf(points)
+	for _, t := range facesWithSides(loops, 3) {
+		if countNeighboursWithSides(loops, loops[t], 5) == 3 {
+			return augmented(points, []int{t}, loops, centers, normals), nil
+		}
+	}
+	return nil, errors.New("tridiminished icosahedron has no triangle surrounded by pentagons")
+}
+
+// countNeighboursWithSides returns the number of faces with the given number of sides that share an edge with the
+// face with the given loop.
+func countNeighboursWithSides(loops [][]int, loop []int, sides int) int {
+	count := 0
+	for i := range loop {
+		a, b := loop[i], loop[(i+1)%len(loop)]
+		for _, other := range loops {
+			if len(other) != sides {
+				continue
+			}
+			for j := range other {
+				if other[j] == b && other[(j+1)%len(other)] == a {
+					count++
+				}
+			}
+		}
+	}
+	return count
+}
+
+// augmentedTruncatedPoints returns the vertex positions of the truncated solids with cupolae on some of their faces.
+func augmentedTruncatedPoints(k int) []r3.Point {
+	var solid *Polyhedron
+	var sides int
+	switch {
+	case k == 65:
+		solid, sides = NewTruncatedTetrahedron(1), 6
+	case k <= 67:
+		solid, sides = NewTruncatedCube(1), 8
+	default:
+		solid, sides = NewTruncatedDodecahedron(1), 10
+	}
+	points := unitEdgePoints(solid)
+	loops, centers, normals := hullOf(points)
+	candidates := facesWithSides(loops, sides)
+	faces := map[int][]int{
+		65: candidates[:1],
+		66: candidates[:1],
+		67: spreadFaces(normals, candidates, 2, math.Pi),
+		68: candidates[:1],
+		69: spreadFaces(normals, candidates, 2, math.Pi),
+		70: spreadFaces(normals, candidates, 2, metaAngle),
+		71: spreadFaces(normals, candidates, 3, metaAngle),
+	}[k]
+	return augmented(points, faces, loops, centers, normals)
+}
+
+// modifiedRhombicosidodecahedronPoints returns the vertex positions of the rhombicosidodecahedra with some of their
+// pentagonal cupolae rotated or removed.
+func modifiedRhombicosidodecahedronPoints(k int) []r3.Point {
+	points := unitEdgePoints(NewRhombicosidodecahedron(1))
+	loops, centers, normals := hullOf(points)
+	pentagons := facesWithSides(loops, 5)
+	para := spreadFaces(normals, pentagons, 2, math.Pi)
+	meta := spreadFaces(normals, pentagons, 3, metaAngle)
+
+	// Each entry lists the cupolae to rotate and the cupolae to remove.
+	modifications := map[int][2][]int{
+		72: {meta[:1], nil},
+		73: {para, nil},
+		74: {meta[:2], nil},
+		75: {meta, nil},
+		76: {nil, meta[:1]},
+		77: {para[1:], para[:1]},
+		78: {meta[1:2], meta[:1]},
+		79: {meta[1:], meta[:1]},
+		80: {nil, para},
+		81: {nil, meta[:2]},
+		82: {meta[2:], meta[:2]},
+		83: {nil, meta},
+	}[k]
+	for _, f := range modifications[0] {
+		for _, i := range loops[f] {
+			points[i] = rotated(points[i], centers[f], normals[f], math.Pi/5)
+		}
+	}
+	removed := make([]int, 0)
+	for _, f := range modifications[1] {
+		removed = append(removed, loops[f]...)
+	}
+	return withoutIndices(points, removed)
+}
+
+// irregularJohnsonPoints returns the vertex positions of the Johnson solids J84 to J92, which are not derived from
+// other solids. Their shapes are determined by solving for unit edge lengths under their symmetries.
+func irregularJohnsonPoints(k int) []r3.Point {
+	switch k {
+	case 84:
+		// Two edges along the x and y axes and a ring of four vertices between them.
+		x := solveNewton(func(x []float64) []float64 {
+			b, c, d := x[0], x[1], x[2]
+			return []float64{
+				0.25 + c*c + (b-d)*(b-d) - 1,
+				(0.5-c)*(0.5-c) + (b+d)*(b+d) - 1,
+				2*c*c + 4*d*d - 1,
+			}
+		}, []float64{0.8, 0.6, 0.3})
+		b, c, d := x[0], x[1], x[2]
+		return []r3.Point{
+			{X: 0.5, Y: 0, Z: b}, {X: -0.5, Y: 0, Z: b}, {X: 0, Y: 0.5, Z: -b}, {X: 0, Y: -0.5, Z: -b},
+			{X: c, Y: 0, Z: -d}, {X: -c, Y: 0, Z: -d}, {X: 0, Y: c, Z: d}, {X: 0, Y: -c, Z: d},
+		}
+	case 85:
+		// Two squares and a zig-zag band of eight vertices between them.
+		r1 := 1 / math.Sqrt2
+		x := solveNewton(func(x []float64) []float64 {
+			h1, r2, h2 := x[0], x[1], x[2]
+			c := math.Cos(math.Pi / 4)
+			return []float64{
+				r1*r1 + r2*r2 - 2*r1*r2*c + (h1-h2)*(h1-h2) - 1,
+				2*r2*r2*(1-c) + 4*h2*h2 - 1,
+				(r1-r2)*(r1-r2) + (h1+h2)*(h1+h2) - 1,
+			}
+		}, []float64{0.8, 1.2, 0.1})
+		h1, r2, h2 := x[0], x[1], x[2]
+		points := append(regularPolygon(4, h1, 0), regularPolygon(4, -h1, math.Pi/4)...)
+		points = append(points, cylindricalPoints(4, r2, h2, math.Pi/4)...)
+		return append(points, cylindricalPoints(4, r2, -h2, 0)...)
+	case 86, 87:
+		// A wedge of two squares, two vertices at its ends and an edge below.
+		x := solveNewton(func(x []float64) []float64 {
+			a, z1, e, f, r := x[0], x[1], x[2], x[3], x[4]
+			return []float64{
+				a*a + z1*z1 - 1,
+				(e-0.5)*(e-0.5) + (z1-f)*(z1-f) - 1,
+				a*a + (e-0.5)*(e-0.5) + f*f - 1,
+				(a-0.5)*(a-0.5) + 0.25 + r*r - 1,
+				0.25 + e*e + (f+r)*(f+r) - 1,
+			}
+		}, []float64{0.85, 0.5, 0.8, -0.4, 0.8})
+		a, z1, e, f, r := x[0], x[1], x[2], x[3], x[4]
+		points := wedgePoints(a, z1, e, f)
+		points = append(points, r3.Point{X: 0.5, Y: 0, Z: -r}, r3.Point{X: -0.5, Y: 0, Z: -r})
+		if k == 86 {
+			return points
+		}
+		loops, centers, normals := hullOf(points)
+		return augmented(points, facesWithSides(loops, 4)[:1], loops, centers, normals)
+	case 88:
+		// A wedge of two squares, two vertices at its ends and a bent band of four vertices below.
+		x := solveNewton(func(x []float64) []float64 {
+			a, z1, e, f, r, q, s := x[0], x[1], x[2], x[3], x[4], x[5], x[6]
+			return []float64{
+				a*a + z1*z1 - 1,
+				(e-0.5)*(e-0.5) + (z1-f)*(z1-f) - 1,
+				a*a + (e-0.5)*(e-0.5) + f*f - 1,
+				(a-0.5)*(a-0.5) + 0.25 + r*r - 1,
+				(e-q)*(e-q) + (f+s)*(f+s) - 1,
+				0.25 + q*q + (s-r)*(s-r) - 1,
+				a*a + (q-0.5)*(q-0.5) + s*s - 1,
+			}
+		}, []float64{0.85, 0.5, 0.8, -0.3, 0.75, 0.6, 0.5})
+		a, z1, e, f, r, q, s := x[0], x[1], x[2], x[3], x[4], x[5], x[6]
+		return append(wedgePoints(a, z1, e, f),
+			r3.Point{X: 0.5, Y: 0, Z: -r}, r3.Point{X: -0.5, Y: 0, Z: -r},
+			r3.Point{X: 0, Y: q, Z: -s}, r3.Point{X: 0, Y: -q, Z: -s})
+	case 89:
+		// A blunt wedge of three squares, two vertices at its ends and a bent band of four vertices below.
+		x := solveNewton(func(x []float64) []float64 {
+			a, z1, e, f, r, q, s := x[0], x[1], x[2], x[3], x[4], x[5], x[6]
+			return []float64{
+				(a-0.5)*(a-0.5) + z1*z1 - 1,
+				0.25 + (e-0.5)*(e-0.5) + (f-z1)*(f-z1) - 1,
+				a*a + (e-0.5)*(e-0.5) + f*f - 1,
+				(a-0.5)*(a-0.5) + 0.25 + r*r - 1,
+				a*a + (q-0.5)*(q-0.5) + s*s - 1,
+				(e-q)*(e-q) + (f+s)*(f+s) - 1,
+				0.25 + q*q + (s-r)*(s-r) - 1,
+			}
+		}, []float64{0.9, 0.9, 0.9, 0.15, 0.7, 0.6, 0.5})
+		a, z1, e, f, r, q, s := x[0], x[1], x[2], x[3], x[4], x[5], x[6]
+		return []r3.Point{
+			{X: 0.5, Y: 0.5, Z: z1}, {X: -0.5, Y: 0.5, Z: z1}, {X: -0.5, Y: -0.5, Z: z1}, {X: 0.5, Y: -0.5, Z: z1},
+			{X: a, Y: 0.5, Z: 0}, {X: -a, Y: 0.5, Z: 0}, {X: -a, Y: -0.5, Z: 0}, {X: a, Y: -0.5, Z: 0},
+			{X: 0, Y: e, Z: f}, {X: 0, Y: -e, Z: f},
+			{X: 0.5, Y: 0, Z: -r}, {X: -0.5, Y: 0, Z: -r},
+			{X: 0, Y: q, Z: -s}, {X: 0, Y: -q, Z: -s},
+		}
+	case 90:
+		// Two wedges of two squares at right angles to each other and a girdle of four vertices between them.
+		x := solveNewton(func(x []float64) []float64 {
+			a, z1, z2, g, w := x[0], x[1], x[2], x[3], x[4]
+			return []float64{
+				a*a + (z1-z2)*(z1-z2) - 1,
+				(g-0.5)*(g-0.5) + (z1-w)*(z1-w) - 1,
+				a*a + (g-0.5)*(g-0.5) + (z2-w)*(z2-w) - 1,
+				0.25 + (g-a)*(g-a) + (w+z2)*(w+z2) - 1,
+				2*(a-0.5)*(a-0.5) + 4*z2*z2 - 1,
+			}
+		}, []float64{0.8, 1.05, 0.45, 1.3, 0.2})
+		a, z1, z2, g, w := x[0], x[1], x[2], x[3], x[4]
+		points := make([]r3.Point, 0, 16)
+		for _, p := range []r3.Point{
+			{X: 0, Y: 0.5, Z: z1}, {X: 0, Y: -0.5, Z: z1},
+			{X: a, Y: 0.5, Z: z2}, {X: -a, Y: 0.5, Z: z2}, {X: a, Y: -0.5, Z: z2}, {X: -a, Y: -0.5, Z: z2},
+			{X: 0, Y: g, Z: w}, {X: 0, Y: -g, Z: w},
+		} {
+			// The lower half is the upper half turned by a quarter and mirrored.
+			points = append(points, p, r3.Point{X: p.Y, Y: p.X, Z: -p.Z})
+		}
+		return points
+	case 91:
+		// Two squares with triangles on opposite edges, the vertices all lie on planes of symmetry.
+		points := make([]r3.Point, 0, 14)
+		for _, sx := range []float64{-0.5, 0.5} {
+			for _, sy := range []float64{-0.5, 0.5} {
+				for _, sz := range []float64{-phi / 2, phi / 2} {
+					points = append(points, r3.Point{X: sx, Y: sy, Z: sz})
+				}
+			}
+		}
+		for _, s := range []float64{-1, 1} {
+			points = append(points,
+				r3.Point{X: 0, Y: s * phi * phi / 2, Z: 0.5},
+				r3.Point{X: 0, Y: s * phi * phi / 2, Z: -0.5},
+				r3.Point{X: s * phi / 2, Y: 0, Z: 0})
+		}
+		return points
+	}
+	return hebesphenorotundaPoints()
+}
+
+// cylindricalPoints returns n points at the given radius and height, evenly spaced around the z axis starting at the
+// given angle.
+func cylindricalPoints(n int, radius, z, angle float64) []r3.Point {
+	points := make([]r3.Point, n)
+	for i := range points {
+		a := angle + 2*math.Pi*float64(i)/float64(n)
+		points[i] = r3.Point{X: radius * math.Cos(a), Y: radius * math.Sin(a), Z: z}
+	}
+	return points
+}
+
+// wedgePoints returns the vertices of a wedge of two squares with its ridge along the y axis at height z1, its lower
+// edges at x=±a and height 0, together with two vertices beyond the ends of the ridge at (0, ±e, f).
+func wedgePoints(a, z1, e, f float64) []r3.Point {
+	return []r3.Point{
+		{X: 0, Y: 0.5, Z: z1}, {X: 0, Y: -0.5, Z: z1},
+		{X: a, Y: 0.5, Z: 0}, {X: -a, Y: 0.5, Z: 0}, {X: a, Y: -0.5, Z: 0}, {X: -a, Y: -0.5, Z: 0},
+		{X: 0, Y: e, Z: f}, {X: 0, Y: -e, Z: f},
+	}
+}
+
+// hebesphenorotundaPoints returns the vertex positions of the triangular hebesphenorotunda, which consists of the
+// twelve vertices of an icosidodecahedron around one of its triangles and a hexagon below them.
+func hebesphenorotundaPoints() []r3.Point {
+	ico := unitEdgePoints(NewIcosidodecahedron(1))
+	loops, _, normals := hullOf(ico)
+	triangle := facesWithSides(loops, 3)[0]
+	// Turn the triangle to the top.
+	z := r3.Vector{X: 0, Y: 0, Z: 1}
+	axis := normals[triangle].Cross(z)
+	angle := math.Acos(normals[triangle].Dot(z))
+	byHeight := make([]r3.Point, len(ico))
+	for i, p := range ico {
+		byHeight[i] = rotated(p, origin, axis, angle)
+	}
+	sort.Slice(byHeight, func(i, j int) bool { return byHeight[i].Z > byHeight[j].Z })
+	crown := byHeight[:12]
+
+	// The squares hang from the lowest horizontal edges of the crown, one of them is centered on mid.
+	var mid r3.Point
+	lowest := math.Inf(1)
+	for i := range crown {
+		for j := i + 1; j < len(crown); j++ {
+			horizontal := math.Abs(crown[i].Z-crown[j].Z) < 1e-9
+			if horizontal && math.Abs(r3.Distance(crown[i], crown[j])-1) < 1e-9 && crown[i].Z < lowest {
+				lowest = crown[i].Z
+				mid = r3.Centroid3D([]r3.Point{crown[i], crown[j]})
+			}
+		}
+	}
+	inradius := math.Sqrt(3) / 2
+	radial := math.Hypot(mid.X, mid.Y)
+	height := mid.Z - math.Sqrt(1-(radial-inradius)*(radial-inradius))
+	hexagon := regularPolygon(6, height, math.Atan2(mid.Y, mid.X)+math.Pi/6)
+	return append(append([]r3.Point(nil), crown...), hexagon...)
+}
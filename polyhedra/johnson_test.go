@@ -0,0 +1,126 @@
+package polyhedra
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// johnsonCounts contains the number of vertices, edges and faces of the Johnson solids J1 to J92.
+var johnsonCounts = [...][3]int{
+	{5, 8, 5}, {6, 10, 6}, {9, 15, 8}, {12, 20, 10}, {15, 25, 12}, {20, 35, 17},
+	{7, 12, 7}, {9, 16, 9}, {11, 20, 11}, {9, 20, 13}, {11, 25, 16},
+	{5, 9, 6}, {7, 15, 10}, {8, 15, 9}, {10, 20, 12}, {12, 25, 15}, {10, 24, 16},
+	{15, 27, 14}, {20, 36, 18}, {25, 45, 22}, {30, 55, 27},
+	{15, 33, 20}, {20, 44, 26}, {25, 55, 32}, {30, 65, 37},
+	{8, 14, 8},
+	{12, 24, 14}, {16, 32, 18}, {16, 32, 18}, {20, 40, 22}, {20, 40, 22}, {25, 50, 27}, {25, 50, 27}, {30, 60, 32},
+	{18, 36, 20}, {18, 36, 20}, {24, 48, 26}, {30, 60, 32}, {30, 60, 32}, {35, 70, 37}, {35, 70, 37},
+	{40, 80, 42}, {40, 80, 42},
+	{18, 42, 26}, {24, 56, 34}, {30, 70, 42}, {35, 80, 47}, {40, 90, 52},
+	{7, 13, 8}, {8, 17, 11}, {9, 21, 14}, {11, 19, 10}, {12, 23, 13},
+	{13, 22, 11}, {14, 26, 14}, {14, 26, 14}, {15, 30, 17},
+	{21, 35, 16}, {22, 40, 20}, {22, 40, 20}, {23, 45, 24},
+	{10, 20, 12}, {9, 15, 8}, {10, 18, 10},
+	{15, 27, 14}, {28, 48, 22}, {32, 60, 30}, {65, 105, 42}, {70, 120, 52}, {70, 120, 52}, {75, 135, 62},
+	{60, 120, 62}, {60, 120, 62}, {60, 120, 62}, {60, 120, 62},
+	{55, 105, 52}, {55, 105, 52}, {55, 105, 52}, {55, 105, 52},
+	{50, 90, 42}, {50, 90, 42}, {50, 90, 42}, {45, 75, 32},
+	{8, 18, 12}, {16, 40, 26}, {10, 22, 14}, {11, 26, 17}, {12, 28, 18}, {14, 33, 21}, {16, 38, 24}, {14, 26, 14},
+	{18, 36, 20},
+}
+
+// assertRegularFaces checks that all faces are regular polygons with unit edges.
+func assertRegularFaces(p *Polyhedron, t *testing.T) {
+	for _, f := range p.Faces() {
+		n := len(f.Loop())
+		center := p.FaceCenter(f)
+		for _, v := range f.Loop() {
+			if d := r3.Distance(center, p.VertexPosition(v)); math.Abs(d-polygonCircumradius(n)) > 1e-9 {
+				t.Errorf("Face %v is not a regular polygon with unit edges", f.String())
+				break
+			}
+		}
+	}
+}
+
+// johnsonSolid creates the Johnson solid Jk and fails the test if that is not possible.
+func johnsonSolid(k int, t *testing.T) *Polyhedron {
+	p, err := NewJohnsonSolid(k)
+	if err != nil {
+		t.Fatalf("Creating J%v failed: %v", k, err)
+	}
+	return p
+}
+
+func TestJohnsonSolids(t *testing.T) {
+	solids := make([]*Polyhedron, JohnsonSolidCount+1)
+	for k := 1; k <= JohnsonSolidCount; k++ {
+		p := johnsonSolid(k, t)
+		solids[k] = p
+		counts := johnsonCounts[k-1]
+		if len(p.Vertices()) != counts[0] || len(p.Edges()) != counts[1] || len(p.Faces()) != counts[2] {
+			t.Errorf("J%v has %v vertices, %v edges and %v faces instead of %v", k,
+				len(p.Vertices()), len(p.Edges()), len(p.Faces()), counts)
+			continue
+		}
+		assertRegularEdges(p, t)
+		assertRegularFaces(p, t)
+		assertOutwardWinding(p, t)
+		if c := p.vertexCentroid(p.Vertices()); r3.Distance(origin, c) > 1e-9 {
+			t.Errorf("J%v is centered on %v instead of the origin", k, c)
+		}
+		if issues := NewIntegrityChecker().CheckIntegrity(p); len(issues) != 0 {
+			t.Errorf("J%v has integrity issues: %v", k, issues)
+		}
+	}
+
+	// Solids with the same counts, like the gyrate and diminished rhombicosidodecahedra, must still differ.
+	for k := 1; k <= JohnsonSolidCount; k++ {
+		for l := k + 1; l <= JohnsonSolidCount; l++ {
+			if johnsonCounts[k-1] != johnsonCounts[l-1] {
+				continue
+			}
+			dk, dl := sortedDistances(solids[k]), sortedDistances(solids[l])
+			congruent := true
+			for i := range dk {
+				if math.Abs(dk[i]-dl[i]) > 1e-9 {
+					congruent = false
+					break
+				}
+			}
+			if congruent {
+				t.Errorf("J%v and J%v are congruent", k, l)
+			}
+		}
+	}
+}
+
+func TestJohnsonSolidNames(t *testing.T) {
+	if name := JohnsonSolidName(92); name != "triangular hebesphenorotunda" {
+		t.Errorf("J92 is called %q", name)
+	}
+	if name := JohnsonSolidName(93); name != "" {
+		t.Errorf("J93 is called %q", name)
+	}
+	for k := 1; k <= JohnsonSolidCount; k++ {
+		if l, ok := LookupJohnsonSolid(JohnsonSolidName(k)); !ok || l != k {
+			t.Errorf("Looking up %q returned J%v", JohnsonSolidName(k), l)
+		}
+	}
+	if k, ok := LookupJohnsonSolid("  Square Gyrobicupola "); !ok || k != 29 {
+		t.Errorf("Looking up the square gyrobicupola returned J%v", k)
+	}
+	if _, ok := LookupJohnsonSolid("cube"); ok {
+		t.Error("The cube is not a Johnson solid")
+	}
+}
+
+func TestNewJohnsonSolidOutOfRange(t *testing.T) {
+	for _, k := range []int{0, JohnsonSolidCount + 1} {
+		if p, err := NewJohnsonSolid(k); err == nil || p != nil {
+			t.Errorf("Expected an error for J%v but got %v", k, p)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package polyhedra
+
+import (
+	"math"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// The Johnson solids are assembled as point sets with unit edge length, the faces are those of the convex hull.
+// Most of them are either stacks of caps and prisms or antiprisms along the z axis, or other solids that got pyramids
+// or cupolae added, removed or rotated.
+
+// capKind is a solid that can be put onto a ring of vertices.
+type capKind int
+
+const (
+	noCap capKind = iota
+	pyramidCap
+	cupolaCap
+	rotundaCap
+)
+
+// middleKind is a segment that can be put between two rings of vertices.
+type middleKind int
+
+const (
+	noMiddle middleKind = iota
+	prismMiddle
+	antiprismMiddle
+)
+
+// cupolaHeight returns the height of a cupola with a regular n-gon at the top.
+func cupolaHeight(n int) float64 {
+	top := regularPolygon(n, 0, 0)[0]
+	base := regularPolygon(2*n, 0, math.Pi/float64(2*n))[0]
+	return math.Sqrt(1 - r3.Distance(top, base)*r3.Distance(top, base))
+}
+
+// antiprismHeight returns the distance between the two n-gons of a uniform antiprism.
+func antiprismHeight(n int) float64 {
+	r := polygonCircumradius(n)
+	return math.Sqrt(1 - 2*r*r*(1-math.Cos(math.Pi/float64(n))))
+}
+
+// capPoints returns the vertices of a cap on a ring with the given number of sides at height z, excluding those of
+// the ring itself. The cap extends in the direction dir, which is 1 or -1. For cupolae and rotundae one of the
+// triangles that stand on the ring is centered on the given angle.
+func capPoints(kind capKind, ring int, angle, z, dir float64) []r3.Point {
+	switch kind {
+	case pyramidCap:
+		return []r3.Point{{X: 0, Y: 0, Z: z + dir*apexHeight(ring)}}
+	case cupolaCap:
+		n := ring / 2
+		return regularPolygon(n, z+dir*cupolaHeight(n), angle)
+	case rotundaCap:
+		// The rotunda is the upper half of an icosidodecahedron, whose vertices are the edge midpoints of an
+		// icosahedron with circumradius 1, scaled to unit edge length.
+		s := math.Sqrt(10+2*math.Sqrt(5)) / 2
+		points := make([]r3.Point, 0, 10)
+		for k := 0; k < 5; k++ {
+			a := angle + 2*math.Pi*float64(k)/5
+			rm := s * 2 / math.Sqrt(5) * math.Cos(math.Pi/5)
+			zm := z + dir*s/math.Sqrt(5)
+			points = append(points, r3.Point{X: rm * math.Cos(a), Y: rm * math.Sin(a), Z: zm})
+			rt := s / math.Sqrt(5)
+			zt := z + dir*s*(1+1/math.Sqrt(5))/2
+			points = append(points, r3.Point{X: rt * math.Cos(a+math.Pi/5), Y: rt * math.Sin(a+math.Pi/5), Z: zt})
+		}
+		return points
+	}
+	return nil
+}
+
+// axialSolid returns the vertices of a stack of a bottom cap, a middle segment and a top cap around the z axis, joined
+// at rings with the given number of sides. The caps of a stack of two cupolae or rotundae are rotated against each
+// other if gyro is set, otherwise their triangles lie opposite each other.
+func axialSolid(bottom capKind, middle middleKind, top capKind, ring int, gyro bool) []r3.Point {
+	m := float64(ring)
+	// The lower ring has an edge centered on the angle 0.
+	lowerAngle, upperAngle := 0.0, 0.0
+	points := regularPolygon(ring, 0, math.Pi/m)
+	upperZ := 0.0
+	switch middle {
+	case prismMiddle:
+		upperZ = 1
+		points = append(points, regularPolygon(ring, upperZ, math.Pi/m)...)
+	case antiprismMiddle:
+		upperZ = antiprismHeight(ring)
+		upperAngle = math.Pi / m
+		points = append(points, regularPolygon(ring, upperZ, upperAngle+math.Pi/m)...)
+	}
+	if gyro {
+		upperAngle += 2 * math.Pi / m
+	}
+	points = append(points, capPoints(bottom, ring, lowerAngle, 0, -1)...)
+	return append(points, capPoints(top, ring, upperAngle, upperZ, 1)...)
+}
+
+// hullOf returns the loops of the convex hull of the points together with their centroids and outward normals.
+func hullOf(points []r3.Point) (loops [][]int, centers []r3.Point, normals []r3.Vector) {
+	loops = convexHullLoops(points)
+	centers = make([]r3.Point, len(loops))
+	normals = make([]r3.Vector, len(loops))
+	for i, loop := range loops {
+		corners := make([]r3.Point, len(loop))
+		for j, index := range loop {
+			corners[j] = points[index]
+		}
+		centers[i] = r3.Centroid3D(corners)
+		normals[i] = corners[0].VectorTo(corners[1]).Cross(corners[0].VectorTo(corners[2])).Normalised()
+	}
+	return loops, centers, normals
+}
+
+// facesWithSides returns the indices of the loops with the given number of sides.
+func facesWithSides(loops [][]int, sides int) []int {
+	faces := make([]int, 0)
+	for i, loop := range loops {
+		if len(loop) == sides {
+			faces = append(faces, i)
+		}
+	}
+	return faces
+}
+
+// spreadFaces picks count faces out of candidates such that the angle between the normals of any two of them is the
+// given angle, starting with the first candidate. It returns nil if there is no such selection.
+func spreadFaces(normals []r3.Vector, candidates []int, count int, angle float64) []int {
+	selected := []int{candidates[0]}
+	for _, c := range candidates[1:] {
+		if len(selected) == count {
+			break
+		}
+		fits := true
+		for _, s := range selected {
+			if math.Abs(normals[s].Dot(normals[c])-math.Cos(angle)) > 1e-6 {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			selected = append(selected, c)
+		}
+	}
+	if len(selected) < count {
+		return nil
+	}
+	return selected
+}
+
+// pyramidApex returns the apex of a pyramid with unit edges on the face with the given loop.
+func pyramidApex(loop []int, center r3.Point, normal r3.Vector) r3.Point {
+	return center.Add(normal.Scale(apexHeight(len(loop))))
+}
+
+// cupolaTop returns the top polygon of a cupola on the face with the given loop. The triangles of the cupola stand on
+// the edges that start at the loop vertices with the given parity.
+func cupolaTop(points []r3.Point, loop []int, center r3.Point, normal r3.Vector, parity int) []r3.Point {
+	n := len(loop) / 2
+	r := polygonCircumradius(n)
+	top := make([]r3.Point, 0, n)
+	for i := parity; i < len(loop); i += 2 {
+		a, b := points[loop[i]], points[loop[(i+1)%len(loop)]]
+		mid := r3.Centroid3D([]r3.Point{a, b})
+		top = append(top, center.Add(normal.Scale(cupolaHeight(n))).Add(center.VectorTo(mid).Normalised().Scale(r)))
+	}
+	return top
+}
+
+// cupolaParity returns the parity of cupolaTop for which the squares of a cupola on the face with the given loop
+// stand on the edges that the face shares with triangles. The other way round the triangles of the cupola would lie
+// in the planes of the triangles of the solid.
+func cupolaParity(loops [][]int, loop []int) int {
+	a, b := loop[0], loop[1]
+	for _, other := range loops {
+		if len(other) != 3 {
+			continue
+		}
+		for j := range other {
+			if other[j] == b && other[(j+1)%3] == a {
+				return 1
+			}
+		}
+	}
+	return 0
+}
+
+// withoutIndices returns the points except for those with the given indices.
+func withoutIndices(points []r3.Point, indices []int) []r3.Point {
+	removed := make(map[int]bool, len(indices))
+	for _, i := range indices {
+		removed[i] = true
+	}
+	remaining := make([]r3.Point, 0, len(points)-len(indices))
+	for i, p := range points {
+		if !removed[i] {
+			remaining = append(remaining, p)
+		}
+	}
+	return remaining
+}
+
+// rotated returns the point rotated by the given angle around the axis through center.
+func rotated(p, center r3.Point, axis r3.Vector, angle float64) r3.Point {
+	v := center.VectorTo(p)
+	k := axis.Normalised()
+	cos, sin := math.Cos(angle), math.Sin(angle)
+	return center.Add(v.Scale(cos).Add(k.Cross(v).Scale(sin)).Add(k.Scale(k.Dot(v) * (1 - cos))))
+}
+
+// unitEdgePoints returns the vertex positions of the Polyhedron scaled such that its edges have unit length.
+func unitEdgePoints(p *Polyhedron) []r3.Point {
+	scale := 1 / p.EdgeLength(p.Edges()[0])
+	points := make([]r3.Point, len(p.Vertices()))
+	for i, v := range p.Vertices() {
+		points[i] = origin.Add(origin.VectorTo(p.VertexPosition(v)).Scale(scale))
+	}
+	return points
+}
+
+// augmented returns the points of the solid with a pyramid or cupola added onto each of the given faces, where faces
+// with up to five sides get a pyramid.
+func augmented(points []r3.Point, faces []int, loops [][]int, centers []r3.Point, normals []r3.Vector) []r3.Point {
+	result := append([]r3.Point(nil), points...)
+	for _, f := range faces {
+		loop := loops[f]
+		if len(loop) <= 5 {
+			result = append(result, pyramidApex(loop, centers[f], normals[f]))
+		} else {
+			result = append(result, cupolaTop(points, loop, centers[f], normals[f], cupolaParity(loops, loop))...)
+		}
+	}
+	return result
+}
+
+// solveNewton returns a root of f close to x, using Newton's method with a numerical Jacobian.
+func solveNewton(f func(x []float64) []float64, x []float64) []float64 {
+	x = append([]float64(nil), x...)
+	n := len(x)
+	for iteration := 0; iteration < 100; iteration++ {
+		fx := f(x)
+		residual := 0.0
+		for _, v := range fx {
+			residual = math.Max(residual, math.Abs(v))
+		}
+		if residual < 1e-15 {
+			break
+		}
+		// Solve J * dx = -f(x) by Gaussian elimination with partial pivoting.
+		m := make([][]float64, n)
+		for i := range m {
+			m[i] = make([]float64, n+1)
+			m[i][n] = -fx[i]
+		}
+		const h = 1e-8
+		for j := 0; j < n; j++ {
+			xh := append([]float64(nil), x...)
+			xh[j] += h
+			fh := f(xh)
+			for i := 0; i < n; i++ {
+				m[i][j] = (fh[i] - fx[i]) / h
+			}
+		}
+		for col := 0; col < n; col++ {
+			pivot := col
+			for row := col + 1; row < n; row++ {
+				if math.Abs(m[row][col]) > math.Abs(m[pivot][col]) {
+					pivot = row
+				}
+			}
+			m[col], m[pivot] = m[pivot], m[col]
+			for row := col + 1; row < n; row++ {
+				factor := m[row][col] / m[col][col]
+				for k := col; k <= n; k++ {
+					m[row][k] -= factor * m[col][k]
+				}
+			}
+		}
+		for row := n - 1; row >= 0; row-- {
+			sum := m[row][n]
+			for k := row + 1; k < n; k++ {
+				sum -= m[row][k] * m[k][n]
+			}
+			m[row][n] = sum / m[row][row]
+			x[row] += m[row][n]
+		}
+	}
+	return x
+}
@@ -0,0 +1,58 @@
+package polyhedra
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// OBJOption configures optional aspects of WriteOBJ.
+type OBJOption func(*objConfig)
+
+// objConfig collects the options of WriteOBJ.
+type objConfig struct {
+	normals bool
+}
+
+// WithOBJNormals adds a normal for every Face to the output of WriteOBJ, which all corners of the Face refer to.
+func WithOBJNormals() OBJOption {
+	return func(c *objConfig) {
+		c.normals = true
+	}
+}
+
+// WriteOBJ writes the Polyhedron to w in the Wavefront OBJ format. There is a v line with the position of every vertex
+// in the order of Vertices and an f line for every Face in the order of Faces. The faces keep the winding of their
+// loops, which is counter clockwise when seen from outside for the polyhedra created by this package. The IDs of the
+// vertices are replaced by their 1-based position in the list of v lines.
+func WriteOBJ(w io.Writer, p Interface, options ...OBJOption) error {
+	config := objConfig{}
+	for _, option := range options {
+		option(&config)
+	}
+
+	bw := bufio.NewWriter(w)
+	indices := vertexIndices(p)
+	for _, v := range p.Vertices() {
+		pos := p.VertexPosition(v)
+		fmt.Fprintf(bw, "v %v %v %v\n", pos.X, pos.Y, pos.Z)
+	}
+	if config.normals {
+		for _, f := range p.Faces() {
+			n := faceNormal(p, f)
+			fmt.Fprintf(bw, "vn %v %v %v\n", n.X, n.Y, n.Z)
+		}
+	}
+	for i, f := range p.Faces() {
+		bw.WriteString("f")
+		for _, v := range f.Loop() {
+			if config.normals {
+				fmt.Fprintf(bw, " %v//%v", indices[v]+1, i+1)
+			} else {
+				fmt.Fprintf(bw, " %v", indices[v]+1)
+			}
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,80 @@
+package polyhedra
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWriteOBJ(t *testing.T) {
+	p := NewCube(1)
+	var buf bytes.Buffer
+	if err := WriteOBJ(&buf, p); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 8+6 {
+		t.Fatalf("Expected 14 lines, got %v", len(lines))
+	}
+	if lines[0] != "v -0.5773502691896258 -0.5773502691896258 -0.5773502691896258" {
+		t.Errorf("Unexpected first vertex line %q", lines[0])
+	}
+	for i, f := range p.Faces() {
+		expected := "f"
+		for _, v := range f.Loop() {
+			for j, w := range p.Vertices() {
+				if v == w {
+					expected += " " + strconv.Itoa(j+1)
+				}
+			}
+		}
+		if lines[8+i] != expected {
+			t.Errorf("Face line %v is %q instead of %q", i, lines[8+i], expected)
+		}
+	}
+}
+
+func TestWriteOBJWithNormals(t *testing.T) {
+	// The vertices of a Goldberg polyhedron made from a subdivided geodesic do not have contiguous IDs.
+	p, err := NewIcosahedralGoldbergPolyhedron(2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteOBJ(&buf, p, WithOBJNormals()); err != nil {
+		t.Fatal(err)
+	}
+	normals, faces := 0, 0
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "vn":
+			normals++
+		case "f":
+			faces++
+			for _, corner := range fields[1:] {
+				parts := strings.Split(corner, "//")
+				v, _ := strconv.Atoi(parts[0])
+				n, _ := strconv.Atoi(parts[1])
+				if v < 1 || v > len(p.Vertices()) || n != faces {
+					t.Errorf("Invalid corner %q in face %v", corner, faces)
+				}
+			}
+		}
+	}
+	if normals != len(p.Faces()) || faces != len(p.Faces()) {
+		t.Errorf("Expected %v normals and faces, got %v and %v", len(p.Faces()), normals, faces)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestWriteOBJReportsWriteErrors(t *testing.T) {
+	if err := WriteOBJ(failingWriter{}, NewCube(1)); err == nil {
+		t.Error("Expected the error of the writer")
+	}
+}
@@ -0,0 +1,177 @@
+package polyhedra
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// The OFF format of Geomview lists the number of vertices, faces and edges after a header keyword, followed by a line
+// with the position of every vertex and a line with the number of vertices and the 0-based vertex indices of every
+// Face. For the format see http://www.geomview.org/docs/html/OFF.html
+
+// OFFError is returned by ReadOFF for malformed OFF data. Line is the 1-based number of the line with the problem.
+type OFFError struct {
+	Line    int
+	Message string
+}
+
+func (e *OFFError) Error() string {
+	return fmt.Sprintf("invalid OFF data at line %v: %v", e.Line, e.Message)
+}
+
+// WriteOFF writes the Polyhedron to w in the OFF format. The vertices are written in the order of Vertices and the
+// faces in the order of Faces, keeping the winding of their loops.
+func WriteOFF(w io.Writer, p Interface) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "OFF\n%v %v %v\n", len(p.Vertices()), len(p.Faces()), len(p.Edges()))
+	for _, v := range p.Vertices() {
+		pos := p.VertexPosition(v)
+		fmt.Fprintf(bw, "%v %v %v\n", pos.X, pos.Y, pos.Z)
+	}
+	indices := vertexIndices(p)
+	for _, f := range p.Faces() {
+		loop := f.Loop()
+		fmt.Fprint(bw, len(loop))
+		for _, v := range loop {
+			fmt.Fprintf(bw, " %v", indices[v])
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
+
+// offLine is a line of OFF data without comments, split into fields.
+type offLine struct {
+	number int
+	fields []string
+}
+
+// ReadOFF reads a Polyhedron in the OFF format from r. The header keywords OFF, COFF, NOFF and CNOFF are accepted,
+// the colours and normals of the vertices as well as the colours of the faces are skipped. The vertices get the IDs
+// 1 to n in the order of the file and the edges are derived from the faces.
+//
+// Malformed data results in an *OFFError. The Polyhedron is created through NewPolyhedron, so data that does not
+// describe a closed manifold results in one of its errors.
+func ReadOFF(r io.Reader) (*Polyhedron, error) {
+	lines := make([]offLine, 0)
+	scanner := bufio.NewScanner(r)
+	number := 0
+	for scanner.Scan() {
+		number++
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		if fields := strings.Fields(text); len(fields) > 0 {
+			lines = append(lines, offLine{number, fields})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	next := func() (offLine, error) {
+		if len(lines) == 0 {
+			return offLine{}, &OFFError{number + 1, "unexpected end of data"}
+		}
+		line := lines[0]
+		lines = lines[1:]
+		return line, nil
+	}
+
+	header, err := next()
+	if err != nil {
+		return nil, err
+	}
+	keyword := header.fields[0]
+	if keyword != "OFF" && keyword != "COFF" && keyword != "NOFF" && keyword != "CNOFF" {
+		return nil, &OFFError{header.number, fmt.Sprintf("unknown header %q", keyword)}
+	}
+	counts := header
+	counts.fields = counts.fields[1:]
+	if len(counts.fields) == 0 {
+		if counts, err = next(); err != nil {
+			return nil, err
+		}
+	}
+	if len(counts.fields) < 2 {
+		return nil, &OFFError{counts.number, "expected the number of vertices and faces"}
+	}
+	vertexCount, err1 := strconv.Atoi(counts.fields[0])
+	faceCount, err2 := strconv.Atoi(counts.fields[1])
+	if err1 != nil || err2 != nil || vertexCount < 0 || faceCount < 0 {
+		return nil, &OFFError{counts.number, "invalid number of vertices or faces"}
+	}
+	// Every vertex and Face takes a line, so larger counts cannot be right and must not be allocated.
+	if vertexCount > len(lines) || faceCount > len(lines)-vertexCount {
+		return nil, &OFFError{counts.number, fmt.Sprintf("%v vertices and %v faces do not fit into the remaining %v lines",
+			vertexCount, faceCount, len(lines))}
+	}
+
+	// Normals follow the position, colours are at the end of the line.
+	minFields := 3
+	if strings.Contains(keyword, "N") {
+		minFields = 6
+	}
+	vertices := make([]Vertex, vertexCount)
+	positions := make([]r3.Point, vertexCount)
+	for i := range vertices {
+		line, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if len(line.fields) < minFields {
+			return nil, &OFFError{line.number, fmt.Sprintf("expected at least %v values for vertex %v", minFields, i)}
+		}
+		var xyz [3]float64
+		for j := range xyz {
+			if xyz[j], err = strconv.ParseFloat(line.fields[j], 64); err != nil {
+				return nil, &OFFError{line.number, fmt.Sprintf("invalid coordinate %q", line.fields[j])}
+			}
+		}
+		vertices[i] = Vertex(i + 1)
+		positions[i] = r3.Point{X: xyz[0], Y: xyz[1], Z: xyz[2]}
+	}
+
+	faces := make([]Face, faceCount)
+	edges := make([]Edge, 0)
+	edgeSet := make(map[Edge]bool)
+	for i := range faces {
+		line, err := next()
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(line.fields[0])
+		if err != nil || n < 3 || n > len(line.fields)-1 {
+			return nil, &OFFError{line.number, fmt.Sprintf("invalid vertex list for face %v", i)}
+		}
+		loop := make([]Vertex, n)
+		for j := range loop {
+			index, err := strconv.Atoi(line.fields[j+1])
+			if err != nil || index < 0 || index >= vertexCount {
+				return nil, &OFFError{line.number, fmt.Sprintf("invalid vertex index %q", line.fields[j+1])}
+			}
+			loop[j] = vertices[index]
+		}
+		faces[i] = NewFace(loop)
+		for _, e := range faces[i].Edges() {
+			if !edgeSet[e] {
+				edgeSet[e] = true
+				edges = append(edges, e)
+			}
+		}
+	}
+
+	p, err := NewPolyhedron(vertices, edges, faces)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range vertices {
+		p.SetVertexPosition(v, positions[i])
+	}
+	return p, nil
+}
@@ -0,0 +1,181 @@
+package polyhedra
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWriteOFF(t *testing.T) {
+	p := NewTetrahedron(1)
+	var buf bytes.Buffer
+	if err := WriteOFF(&buf, p); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2+4+4 {
+		t.Fatalf("Expected 10 lines, got %v", len(lines))
+	}
+	if lines[0] != "OFF" || lines[1] != "4 4 6" {
+		t.Errorf("Unexpected header %q %q", lines[0], lines[1])
+	}
+	for _, line := range lines[6:] {
+		if fields := strings.Fields(line); len(fields) != 4 || fields[0] != "3" {
+			t.Errorf("Unexpected face line %q", line)
+		}
+	}
+}
+
+func TestOFFRoundTrip(t *testing.T) {
+	// The vertices of a Goldberg polyhedron made from a subdivided geodesic do not have contiguous IDs.
+	p, err := NewIcosahedralGoldbergPolyhedron(2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteOFF(&buf, p); err != nil {
+		t.Fatal(err)
+	}
+	q, err := ReadOFF(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertVertexCount(q, len(p.Vertices()), t)
+	assertEdgeCount(q, len(p.Edges()), t)
+	assertFaceCount(q, len(p.Faces()), t)
+	assertCongruent(p, q, t)
+	assertOutwardWinding(q, t)
+}
+
+func TestReadOFFVariants(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"OFF", `OFF
+# A square pyramid.
+5 5 0
+0 0 1
+1 0 -1 # Comments may follow values.
+0 1 -1
+
+-1 0 -1
+0 -1 -1
+4 1 4 3 2
+3 0 1 2
+3 0 2 3
+3 0 3 4
+3 0 4 1
+`},
+		{"COFF with counts on the header line", `COFF 5 5 8
+0 0 1 1 0 0 1
+1 0 -1 1 0 0 1
+0 1 -1 1 0 0 1
+-1 0 -1 1 0 0 1
+0 -1 -1 1 0 0 1
+4 1 4 3 2 255 0 0
+3 0 1 2 0 255 0
+3 0 2 3 0 255 0
+3 0 3 4 0 255 0
+3 0 4 1 0 255 0
+`},
+		{"NOFF", `NOFF
+5 5 8
+0 0 1 0 0 1
+1 0 -1 1 0 0
+0 1 -1 0 1 0
+-1 0 -1 -1 0 0
+0 -1 -1 0 -1 0
+4 1 4 3 2
+3 0 1 2
+3 0 2 3
+3 0 3 4
+3 0 4 1
+`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := ReadOFF(strings.NewReader(test.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertVertexCount(p, 5, t)
+			assertEdgeCount(p, 8, t)
+			assertFaceCount(p, 5, t)
+			assertOutwardWinding(p, t)
+			if pos := p.VertexPosition(p.Vertices()[3]); pos.X != -1 || pos.Y != 0 || pos.Z != -1 {
+				t.Errorf("Unexpected position %v of the fourth vertex", pos)
+			}
+			for _, v := range p.Vertices() {
+				if len(p.VertexAdjacentFaces(v)) < 3 {
+					t.Errorf("Vertex %v has only %v faces", v, len(p.VertexAdjacentFaces(v)))
+				}
+			}
+		})
+	}
+}
+
+func TestReadOFFErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		line int
+	}{
+		{"unknown header", "PLY\n3 1 0\n", 1},
+		{"missing counts", "OFF\n3\n", 2},
+		{"invalid coordinate", "OFF\n3 1 0\n0 0 0\n1 x 0\n0 1 0\n3 0 1 2\n", 4},
+		{"missing vertices", "OFF\n3 1 0\n0 0 0\n1 0 0\n", 2},
+		{"index out of range", "OFF\n3 1 0\n0 0 0\n1 0 0\n0 1 0\n3 0 1 3\n", 6},
+		{"too few indices", "OFF\n3 1 0\n0 0 0\n1 0 0\n0 1 0\n3 0 1\n", 6},
+		{"huge vertex count", "OFF\n4000000000000000000 1 0\n", 2},
+		{"huge face count", "OFF\n3 4000000000000000000 0\n0 0 0\n1 0 0\n0 1 0\n", 2},
+		{"more faces than lines", "OFF 3 2 0\n0 0 0\n1 0 0\n0 1 0\n3 0 1 2\n", 1},
+		{"huge face size", "OFF\n3 1 0\n0 0 0\n1 0 0\n0 1 0\n9223372036854775807 0 1 2\n", 6},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ReadOFF(strings.NewReader(test.data))
+			var offErr *OFFError
+			if !errors.As(err, &offErr) {
+				t.Fatalf("Expected an OFFError, got %v", err)
+			}
+			if offErr.Line != test.line {
+				t.Errorf("Expected the error at line %v, got %v", test.line, offErr.Line)
+			}
+		})
+	}
+}
+
+func TestReadOFFValidates(t *testing.T) {
+	// A single triangle is not a closed surface.
+	_, err := ReadOFF(strings.NewReader("OFF\n3 1 0\n0 0 0\n1 0 0\n0 1 0\n3 0 1 2\n"))
+	var manifoldErr *NonManifoldEdgeError
+	if !errors.As(err, &manifoldErr) {
+		t.Errorf("Expected a NonManifoldEdgeError, got %v", err)
+	}
+
+	// A tetrahedron with a fifth vertex that no Face uses.
+	_, err = ReadOFF(strings.NewReader(`OFF
+5 4 6
+1 1 1
+1 -1 -1
+-1 1 -1
+-1 -1 1
+0 0 5
+3 0 1 2
+3 0 3 1
+3 0 2 3
+3 1 3 2
+`))
+	var unusedErr *UnusedVertexError
+	if !errors.As(err, &unusedErr) {
+		t.Errorf("Expected an UnusedVertexError, got %v", err)
+	}
+
+	_, err = ReadOFF(strings.NewReader("OFF\n0 0 0\n"))
+	var emptyErr *EmptyPolyhedronError
+	if !errors.As(err, &emptyErr) {
+		t.Errorf("Expected an EmptyPolyhedronError, got %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package polyhedra
+
+import (
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// NewTetrahedron creates a regular tetrahedron with the given circumradius that is centered at the origin.
+// Its faces are wound counter clockwise when seen from outside.
+func NewTetrahedron(radius float64) *Polyhedron {
+	tetra := newTetrahedron(radius)
+	return &tetra
+}
+
+func newTetrahedron(radius float64) Polyhedron {
+	positions := []r3.Point{
+		{X: 1, Y: 1, Z: 1},
+		{X: 1, Y: -1, Z: -1},
+		{X: -1, Y: 1, Z: -1},
+		{X: -1, Y: -1, Z: 1},
+	}
+	for i := range positions {
+		positions[i] = onSphere(positions[i], radius)
+	}
+	return newPolyhedronFromLoops(positions, [][]int{
+		{0, 1, 2},
+		{0, 3, 1},
+		{0, 2, 3},
+		{1, 3, 2},
+	})
+}
+
+// NewCube creates a cube with the given circumradius that is centered at the origin.
+// Its faces are wound counter clockwise when seen from outside and its edges are parallel to the coordinate axes.
+func NewCube(radius float64) *Polyhedron {
+	cube := newCube(radius)
+	return &cube
+}
+
+func newCube(radius float64) Polyhedron {
+	positions := make([]r3.Point, 0, 8)
+	for _, x := range []float64{-1, 1} {
+		for _, y := range []float64{-1, 1} {
+			for _, z := range []float64{-1, 1} {
+				positions = append(positions, onSphere(r3.Point{X: x, Y: y, Z: z}, radius))
+			}
+		}
+	}
+	// The index of each vertex encodes its coordinates as bits (x, y, z).
+	return newPolyhedronFromLoops(positions, [][]int{
+		{0, 1, 3, 2},
+		{4, 6, 7, 5},
+		{0, 4, 5, 1},
+		{2, 3, 7, 6},
+		{0, 2, 6, 4},
+		{1, 5, 7, 3},
+	})
+}
+
+// NewOctahedron creates a regular octahedron with the given circumradius that is centered at the origin.
+// Its faces are wound counter clockwise when seen from outside and its vertices lie on the coordinate axes.
+func NewOctahedron(radius float64) *Polyhedron {
+	octa := newOctahedron(radius)
+	return &octa
+}
+
+func newOctahedron(radius float64) Polyhedron {
+	positions := []r3.Point{
+		// Poles
+		{X: 0, Y: 0, Z: radius},
+		{X: 0, Y: 0, Z: -radius},
+		// Equator
+		{X: radius, Y: 0, Z: 0},
+		{X: 0, Y: radius, Z: 0},
+		{X: -radius, Y: 0, Z: 0},
+		{X: 0, Y: -radius, Z: 0},
+	}
+	loops := make([][]int, 0, 8)
+	for i := 0; i < 4; i++ {
+		a, b := 2+i, 2+(i+1)%4
+		loops = append(loops, []int{0, a, b}, []int{1, b, a})
+	}
+	return newPolyhedronFromLoops(positions, loops)
+}
+
+// NewDodecahedron creates a regular dodecahedron with the given circumradius that is centered at the origin.
+// Its faces are wound counter clockwise when seen from outside. It is the dual of the icosahedron created by
+// NewIcosahedronWithRadius and equals GeodesicToGoldberg applied to NewIcosahedralGeodesic up to scale.
+func NewDodecahedron(radius float64) *Polyhedron {
+	dodeca := newDodecahedron(radius)
+	return &dodeca
+}
+
+func newDodecahedron(radius float64) Polyhedron {
+	gp, err := GeodesicToGoldberg(NewIcosahedralGeodesic())
+	if err != nil {
+		panic(err)
+	}
+	dodeca := gp.Polyhedron
+	for _, v := range dodeca.vertices {
+		dodeca.positions[v] = onSphere(dodeca.positions[v], radius)
+	}
+	return dodeca
+}
@@ -0,0 +1,120 @@
+package polyhedra
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+func assertCircumradius(p Interface, radius float64, t *testing.T) {
+	for _, v := range p.Vertices() {
+		d := r3.Distance(origin, p.VertexPosition(v))
+		if math.Abs(d-radius) > 1e-9 {
+			t.Errorf("Vertex %v has distance %v from the origin instead of %v", v, d, radius)
+		}
+	}
+}
+
+func TestTetrahedronCreation(t *testing.T) {
+	tetra := NewTetrahedron(1)
+	assertVertexCount(tetra, 4, t)
+	assertEdgeCount(tetra, 6, t)
+	assertFaceCount(tetra, 4, t)
+	assertCircumradius(tetra, 1, t)
+	if issues := NewIntegrityChecker().CheckIntegrity(tetra); len(issues) != 0 {
+		t.Errorf("Tetrahedron has integrity issues: %v", issues)
+	}
+}
+
+func TestOctahedronCreation(t *testing.T) {
+	octa := NewOctahedron(1)
+	assertVertexCount(octa, 6, t)
+	assertEdgeCount(octa, 12, t)
+	assertFaceCount(octa, 8, t)
+	assertCircumradius(octa, 1, t)
+	if issues := NewIntegrityChecker().CheckIntegrity(octa); len(issues) != 0 {
+		t.Errorf("Octahedron has integrity issues: %v", issues)
+	}
+}
+
+func assertRegularEdges(p *Polyhedron, t *testing.T) {
+	length := p.EdgeLength(p.Edges()[0])
+	for _, e := range p.Edges() {
+		if math.Abs(p.EdgeLength(e)-length) > 1e-9 {
+			t.Errorf("Edge %v has length %v instead of %v", e, p.EdgeLength(e), length)
+		}
+	}
+}
+
+func assertOutwardWinding(p *Polyhedron, t *testing.T) {
+	for _, f := range p.Faces() {
+		if faceNormal(p, f).Dot(origin.VectorTo(p.FaceCenter(f))) <= 0 {
+			t.Errorf("Face %v is not wound counter clockwise when seen from outside", f.String())
+		}
+	}
+}
+
+func TestPlatonicSolids(t *testing.T) {
+	solids := []struct {
+		name                    string
+		create                  func(radius float64) *Polyhedron
+		vertices, edges, faces  int
+		vertexDegree, faceSides int
+	}{
+		{"tetrahedron", NewTetrahedron, 4, 6, 4, 3, 3},
+		{"cube", NewCube, 8, 12, 6, 3, 4},
+		{"octahedron", NewOctahedron, 6, 12, 8, 4, 3},
+		{"dodecahedron", NewDodecahedron, 20, 30, 12, 3, 5},
+		{"icosahedron", NewIcosahedronWithRadius, 12, 30, 20, 5, 3},
+	}
+	for _, s := range solids {
+		for _, radius := range []float64{1, 2.5} {
+			p := s.create(radius)
+			assertVertexCount(p, s.vertices, t)
+			assertEdgeCount(p, s.edges, t)
+			assertFaceCount(p, s.faces, t)
+			assertCircumradius(p, radius, t)
+			assertRegularEdges(p, t)
+			assertOutwardWinding(p, t)
+			for _, v := range p.Vertices() {
+				if p.VertexDegree(v) != s.vertexDegree {
+					t.Errorf("Vertex %v of the %v has degree %v instead of %v", v, s.name, p.VertexDegree(v), s.vertexDegree)
+				}
+			}
+			for _, f := range p.Faces() {
+				if len(f.Loop()) != s.faceSides {
+					t.Errorf("Face %v of the %v has %v sides instead of %v", f.String(), s.name, len(f.Loop()), s.faceSides)
+				}
+			}
+			if issues := NewIntegrityChecker().CheckIntegrity(p); len(issues) != 0 {
+				t.Errorf("The %v has integrity issues: %v", s.name, issues)
+			}
+		}
+	}
+}
+
+func TestDodecahedronIsGoldbergIcosahedron(t *testing.T) {
+	dodeca := NewDodecahedron(1)
+	gp, err := GeodesicToGoldberg(NewIcosahedralGeodesic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	radius := r3.Distance(origin, gp.VertexPosition(gp.Vertices()[0]))
+
+	assertVertexCount(dodeca, len(gp.Vertices()), t)
+	for i, v := range dodeca.Vertices() {
+		expected := onSphere(gp.VertexPosition(gp.Vertices()[i]), 1)
+		if r3.Distance(expected, dodeca.VertexPosition(v)) > 1e-9 {
+			t.Errorf("Vertex %v is at %v instead of %v", v, dodeca.VertexPosition(v), expected)
+		}
+	}
+	for i, f := range dodeca.Faces() {
+		if !f.Equals(gp.Faces()[i]) {
+			t.Errorf("Face %v differs from %v", f.String(), gp.Faces()[i].String())
+		}
+	}
+	if math.Abs(radius-r3.Distance(origin, gp.VertexPosition(gp.Vertices()[1]))) > 1e-9 {
+		t.Error("Goldberg icosahedron is not a regular dodecahedron")
+	}
+}
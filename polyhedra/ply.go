@@ -0,0 +1,241 @@
+package polyhedra
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// PLYType is the scalar type of a property in a PLY file.
+type PLYType int
+
+const (
+	// PLYUChar stores values as unsigned 8 bit integers, which is common for colour channels.
+	PLYUChar PLYType = iota
+	// PLYInt stores values as signed 32 bit integers.
+	PLYInt
+	// PLYUInt stores values as unsigned 32 bit integers.
+	PLYUInt
+	// PLYFloat stores values as 32 bit floating point numbers.
+	PLYFloat
+	// PLYDouble stores values as 64 bit floating point numbers.
+	PLYDouble
+)
+
+// plyTypeNames are the names of the types in the header of a PLY file.
+var plyTypeNames = map[PLYType]string{
+	PLYUChar:  "uchar",
+	PLYInt:    "int",
+	PLYUInt:   "uint",
+	PLYFloat:  "float",
+	PLYDouble: "double",
+}
+
+// plyIntegerRanges are the smallest and largest values of the integer types.
+var plyIntegerRanges = map[PLYType][2]float64{
+	PLYUChar: {0, math.MaxUint8},
+	PLYInt:   {math.MinInt32, math.MaxInt32},
+	PLYUInt:  {0, math.MaxUint32},
+}
+
+// PLYProperty is an additional column of values for the vertices or faces in a PLY file, for example one of the
+// colour channels red, green and blue, an ID or a value from a simulation. Values holds one value per vertex in the
+// order of Vertices or one value per Face in the order of Faces. Integer types are written after truncation, the
+// truncated values need to lie in the range of the type.
+type PLYProperty struct {
+	Name   string
+	Type   PLYType
+	Values []float64
+}
+
+// PLYOption configures optional aspects of WritePLY.
+type PLYOption func(*plyConfig)
+
+// plyConfig collects the options of WritePLY.
+type plyConfig struct {
+	vertexProperties []PLYProperty
+	faceProperties   []PLYProperty
+}
+
+// WithPLYVertexProperties adds the given properties to the vertices in the output of WritePLY.
+func WithPLYVertexProperties(properties ...PLYProperty) PLYOption {
+	return func(c *plyConfig) {
+		c.vertexProperties = append(c.vertexProperties, properties...)
+	}
+}
+
+// WithPLYFaceProperties adds the given properties to the faces in the output of WritePLY.
+func WithPLYFaceProperties(properties ...PLYProperty) PLYOption {
+	return func(c *plyConfig) {
+		c.faceProperties = append(c.faceProperties, properties...)
+	}
+}
+
+// WritePLY writes the Polyhedron to w in the PLY format with the given encoding. The vertices are written in the
+// order of Vertices with their positions as double properties x, y and z, followed by the additional vertex
+// properties. The faces are written in the order of Faces as lists of 0-based vertex indices, followed by the
+// additional face properties. The faces keep the winding of their loops. The number of vertices of a Face is written
+// as uchar, unless a Face has more than 255 vertices, then it is written as uint.
+//
+// An error is returned without writing anything if the encoding is unknown, or if a property has an unknown type,
+// does not have one value per vertex or Face or has a value outside the range of its type. The same holds if the name
+// of a property is empty, contains whitespace or is already used by another property of the same element, including
+// x, y and z of the vertices and vertex_indices of the faces.
+func WritePLY(w io.Writer, p Interface, encoding Encoding, options ...PLYOption) error {
+	if err := checkEncoding(encoding); err != nil {
+		return err
+	}
+	config := plyConfig{}
+	for _, option := range options {
+		option(&config)
+	}
+	vertices, faces := p.Vertices(), p.Faces()
+	vertexNames := map[string]bool{"x": true, "y": true, "z": true}
+	for _, property := range config.vertexProperties {
+		if err := checkPLYProperty(property, "vertex", "vertices", len(vertices), vertexNames); err != nil {
+			return err
+		}
+	}
+	faceNames := map[string]bool{"vertex_indices": true}
+	for _, property := range config.faceProperties {
+		if err := checkPLYProperty(property, "face", "faces", len(faces), faceNames); err != nil {
+			return err
+		}
+	}
+	countType := PLYUChar
+	for _, f := range faces {
+		if len(f.Loop()) > math.MaxUint8 {
+			countType = PLYUInt
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	format := "ascii"
+	if encoding == BinaryEncoding {
+		format = "binary_little_endian"
+	}
+	fmt.Fprintf(bw, "ply\nformat %v 1.0\ncomment written by polyhedra\n", format)
+	fmt.Fprintf(bw, "element vertex %v\nproperty double x\nproperty double y\nproperty double z\n", len(vertices))
+	for _, property := range config.vertexProperties {
+		fmt.Fprintf(bw, "property %v %v\n", plyTypeNames[property.Type], property.Name)
+	}
+	fmt.Fprintf(bw, "element face %v\nproperty list %v int vertex_indices\n", len(faces), plyTypeNames[countType])
+	for _, property := range config.faceProperties {
+		fmt.Fprintf(bw, "property %v %v\n", plyTypeNames[property.Type], property.Name)
+	}
+	fmt.Fprintln(bw, "end_header")
+
+	e := plyEncoder{bw, encoding == BinaryEncoding, true}
+	for i, v := range vertices {
+		pos := p.VertexPosition(v)
+		e.write(PLYDouble, pos.X)
+		e.write(PLYDouble, pos.Y)
+		e.write(PLYDouble, pos.Z)
+		for _, property := range config.vertexProperties {
+			e.write(property.Type, property.Values[i])
+		}
+		e.endLine()
+	}
+	indices := vertexIndices(p)
+	for i, f := range faces {
+		loop := f.Loop()
+		e.write(countType, float64(len(loop)))
+		for _, v := range loop {
+			e.write(PLYInt, float64(indices[v]))
+		}
+		for _, property := range config.faceProperties {
+			e.write(property.Type, property.Values[i])
+		}
+		e.endLine()
+	}
+	return bw.Flush()
+}
+
+// checkPLYProperty returns an error if the property has an invalid name, an unknown type, a number of values other
+// than count or a value outside the range of its type. Element is the name of the element in the singular and the
+// plural. Names holds the names of the properties of the element so far, the name of the property is added to it.
+func checkPLYProperty(property PLYProperty, element, elements string, count int, names map[string]bool) error {
+	if property.Name == "" {
+		return fmt.Errorf("%v property has an empty name", element)
+	}
+	if strings.IndexFunc(property.Name, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("%v property %q has whitespace in its name", element, property.Name)
+	}
+	if names[property.Name] {
+		return fmt.Errorf("%v property %v is defined more than once", element, property.Name)
+	}
+	names[property.Name] = true
+	if _, ok := plyTypeNames[property.Type]; !ok {
+		return fmt.Errorf("%v property %v has unknown type %v", element, property.Name, int(property.Type))
+	}
+	if len(property.Values) != count {
+		return fmt.Errorf("%v property %v has %v values for %v %v", element, property.Name, len(property.Values),
+			count, elements)
+	}
+	if r, ok := plyIntegerRanges[property.Type]; ok {
+		for i, value := range property.Values {
+			// NaN fails both comparisons.
+			if truncated := math.Trunc(value); !(truncated >= r[0] && truncated <= r[1]) {
+				return fmt.Errorf("%v property %v has value %v at index %v outside the range of %v", element,
+					property.Name, value, i, plyTypeNames[property.Type])
+			}
+		}
+	}
+	return nil
+}
+
+// plyEncoder writes the values of the elements of a PLY file.
+type plyEncoder struct {
+	w         *bufio.Writer
+	binary    bool
+	lineStart bool
+}
+
+// write writes a single value with the given type.
+func (e *plyEncoder) write(t PLYType, value float64) {
+	if e.binary {
+		var buf [8]byte
+		switch t {
+		case PLYUChar:
+			e.w.WriteByte(byte(value))
+		case PLYInt:
+			binary.LittleEndian.PutUint32(buf[:], uint32(int32(value)))
+			e.w.Write(buf[:4])
+		case PLYUInt:
+			binary.LittleEndian.PutUint32(buf[:], uint32(value))
+			e.w.Write(buf[:4])
+		case PLYFloat:
+			binary.LittleEndian.PutUint32(buf[:], math.Float32bits(float32(value)))
+			e.w.Write(buf[:4])
+		default:
+			binary.LittleEndian.PutUint64(buf[:], math.Float64bits(value))
+			e.w.Write(buf[:])
+		}
+		return
+	}
+
+	if !e.lineStart {
+		e.w.WriteByte(' ')
+	}
+	e.lineStart = false
+	switch t {
+	case PLYUChar, PLYInt, PLYUInt:
+		fmt.Fprintf(e.w, "%d", int64(value))
+	case PLYFloat:
+		fmt.Fprintf(e.w, "%v", float32(value))
+	default:
+		fmt.Fprintf(e.w, "%v", value)
+	}
+}
+
+// endLine ends the current element, which starts a new line in ASCII files.
+func (e *plyEncoder) endLine() {
+	if !e.binary {
+		e.w.WriteByte('\n')
+	}
+	e.lineStart = true
+}
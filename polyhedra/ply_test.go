@@ -0,0 +1,188 @@
+package polyhedra
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestWriteASCIIPLY(t *testing.T) {
+	p := NewTetrahedron(1)
+	ids := PLYProperty{"cell_id", PLYInt, []float64{10, 11, 12, 13}}
+	degrees := PLYProperty{"degree", PLYUChar, []float64{3, 3, 3, 3}}
+	area := PLYProperty{"area", PLYFloat, []float64{0.5, 0.5, 0.5, 0.5}}
+	var buf bytes.Buffer
+	err := WritePLY(&buf, p, ASCIIEncoding, WithPLYVertexProperties(degrees), WithPLYFaceProperties(ids, area))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.SplitN(buf.String(), "end_header\n", 2)
+	header := parts[0]
+	for _, line := range []string{"format ascii 1.0", "element vertex 4", "property uchar degree",
+		"element face 4", "property list uchar int vertex_indices", "property int cell_id", "property float area"} {
+		if !strings.Contains(header, line+"\n") {
+			t.Errorf("Header misses %q", line)
+		}
+	}
+	lines := strings.Split(strings.TrimSpace(parts[1]), "\n")
+	if len(lines) != 8 {
+		t.Fatalf("Expected 8 element lines, got %v", len(lines))
+	}
+	if fields := strings.Fields(lines[0]); len(fields) != 4 || fields[3] != "3" {
+		t.Errorf("Unexpected vertex line %q", lines[0])
+	}
+	if fields := strings.Fields(lines[4]); len(fields) != 6 || fields[0] != "3" || fields[4] != "10" ||
+		fields[5] != "0.5" {
+		t.Errorf("Unexpected face line %q", lines[4])
+	}
+}
+
+func TestWriteBinaryPLY(t *testing.T) {
+	p, err := NewIcosahedralGoldbergPolyhedron(2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := make([]float64, len(p.Faces()))
+	for i := range values {
+		values[i] = float64(i)
+	}
+	var buf bytes.Buffer
+	err = WritePLY(&buf, p, BinaryEncoding, WithPLYFaceProperties(PLYProperty{"value", PLYDouble, values}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := bytes.SplitN(buf.Bytes(), []byte("end_header\n"), 2)
+	if !bytes.Contains(parts[0], []byte("format binary_little_endian 1.0\n")) {
+		t.Error("Header does not declare the binary format")
+	}
+	data := parts[1]
+	offset := len(p.Vertices()) * 3 * 8
+	indices := vertexIndices(p)
+	for i, f := range p.Faces() {
+		loop := f.Loop()
+		if int(data[offset]) != len(loop) {
+			t.Fatalf("Face %v has %v vertices instead of %v", i, data[offset], len(loop))
+		}
+		offset++
+		for _, v := range loop {
+			if int(binary.LittleEndian.Uint32(data[offset:])) != indices[v] {
+				t.Errorf("Face %v refers to the wrong vertex", i)
+			}
+			offset += 4
+		}
+		if value := math.Float64frombits(binary.LittleEndian.Uint64(data[offset:])); value != values[i] {
+			t.Errorf("Face %v has value %v instead of %v", i, value, values[i])
+		}
+		offset += 8
+	}
+	if offset != len(data) {
+		t.Errorf("Expected %v bytes of data, got %v", offset, len(data))
+	}
+}
+
+func TestWritePLYRejectsMismatchedProperties(t *testing.T) {
+	var buf bytes.Buffer
+	err := WritePLY(&buf, NewCube(1), ASCIIEncoding, WithPLYVertexProperties(PLYProperty{"red", PLYUChar, []float64{1}}))
+	if err == nil || buf.Len() != 0 {
+		t.Errorf("Expected an error without output, got %v and %v bytes", err, buf.Len())
+	}
+}
+
+func TestWritePLYLargeFaces(t *testing.T) {
+	p, err := NewPrism(300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WritePLY(&buf, p, ASCIIEncoding); err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.SplitN(buf.String(), "end_header\n", 2)
+	if !strings.Contains(parts[0], "property list uint int vertex_indices\n") {
+		t.Error("Header does not declare uint vertex counts")
+	}
+	lines := strings.Split(strings.TrimSpace(parts[1]), "\n")
+	if fields := strings.Fields(lines[len(lines)-1]); fields[0] != "300" || len(fields) != 301 {
+		t.Errorf("Last face has %v fields and count %v", len(fields), fields[0])
+	}
+
+	buf.Reset()
+	if err := WritePLY(&buf, p, BinaryEncoding); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.SplitN(buf.Bytes(), []byte("end_header\n"), 2)[1]
+	offset := len(p.Vertices()) * 3 * 8
+	for i, f := range p.Faces() {
+		if count := int(binary.LittleEndian.Uint32(data[offset:])); count != len(f.Loop()) {
+			t.Fatalf("Face %v has %v vertices instead of %v", i, count, len(f.Loop()))
+		}
+		offset += 4 + 4*len(f.Loop())
+	}
+	if offset != len(data) {
+		t.Errorf("Expected %v bytes of data, got %v", offset, len(data))
+	}
+}
+
+func TestWritePLYRejectsInvalidProperties(t *testing.T) {
+	tests := []struct {
+		name     string
+		property PLYProperty
+	}{
+		{"unknown type", PLYProperty{"value", PLYType(7), []float64{1, 2, 3, 4}}},
+		{"uchar above range", PLYProperty{"red", PLYUChar, []float64{1, 2, 256, 4}}},
+		{"uint below range", PLYProperty{"id", PLYUInt, []float64{1, -1, 3, 4}}},
+		{"int above range", PLYProperty{"id", PLYInt, []float64{1, 2, 3, 1 << 31}}},
+		{"NaN as int", PLYProperty{"id", PLYInt, []float64{math.NaN(), 2, 3, 4}}},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		err := WritePLY(&buf, NewTetrahedron(1), BinaryEncoding, WithPLYFaceProperties(test.property))
+		if err == nil || buf.Len() != 0 {
+			t.Errorf("%v: expected an error without output, got %v and %v bytes", test.name, err, buf.Len())
+		}
+	}
+	// Values are truncated before the range check.
+	var buf bytes.Buffer
+	property := PLYProperty{"red", PLYUChar, []float64{255.5, -0.5, 0, 1}}
+	if err := WritePLY(&buf, NewTetrahedron(1), ASCIIEncoding, WithPLYFaceProperties(property)); err != nil {
+		t.Errorf("Expected truncated values in range to be accepted, got %v", err)
+	}
+}
+
+func TestWritePLYRejectsInvalidPropertyNames(t *testing.T) {
+	values := []float64{1, 2, 3, 4}
+	tests := []struct {
+		name   string
+		option PLYOption
+	}{
+		{"empty name", WithPLYFaceProperties(PLYProperty{"", PLYInt, values})},
+		{"space in name", WithPLYFaceProperties(PLYProperty{"cell id", PLYInt, values})},
+		{"newline in name", WithPLYVertexProperties(PLYProperty{"id\nend_header", PLYInt, values})},
+		{"duplicate name", WithPLYFaceProperties(PLYProperty{"id", PLYInt, values}, PLYProperty{"id", PLYUInt, values})},
+		{"vertex property x", WithPLYVertexProperties(PLYProperty{"x", PLYDouble, values})},
+		{"vertex property z", WithPLYVertexProperties(PLYProperty{"z", PLYDouble, values})},
+		{"face property vertex_indices", WithPLYFaceProperties(PLYProperty{"vertex_indices", PLYInt, values})},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := WritePLY(&buf, NewTetrahedron(1), ASCIIEncoding, test.option); err == nil || buf.Len() != 0 {
+			t.Errorf("%v: expected an error without output, got %v and %v bytes", test.name, err, buf.Len())
+		}
+	}
+	// Vertices and faces have separate names.
+	var buf bytes.Buffer
+	err := WritePLY(&buf, NewTetrahedron(1), ASCIIEncoding, WithPLYVertexProperties(PLYProperty{"id", PLYInt, values}),
+		WithPLYFaceProperties(PLYProperty{"id", PLYInt, values}, PLYProperty{"x", PLYDouble, values}))
+	if err != nil {
+		t.Errorf("Expected the same name on vertices and faces to be accepted, got %v", err)
+	}
+}
+
+func TestWritePLYUnknownEncoding(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WritePLY(&buf, NewCube(1), Encoding(-1)); err == nil || buf.Len() != 0 {
+		t.Errorf("Expected an error without output, got %v and %v bytes", err, buf.Len())
+	}
+}
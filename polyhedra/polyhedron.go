@@ -1,9 +1,32 @@
 // Package polyhedra implements basic functionality to create and modify geometric polyhedra.
+//
+// All constructors and modifiers only touch the polyhedra they create or are called on, so different polyhedra can be
+// created and modified from many goroutines at once. A Polyhedron that is not being modified can be read from many
+// goroutines at once, which includes using it as the input of a constructor such as GeodesicToGoldberg.
 package polyhedra
 
+import (
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
 // NewPolyhedron creates a Polyhedron from the given vertices, edges and faces.
+// All vertices are placed at the origin, their positions can be set through SetVertexPosition.
+//
+// The input needs to describe a closed manifold Polyhedron. Otherwise one of *EmptyPolyhedronError,
+// *DuplicateVertexError, *DanglingEdgeError, *SelfLoopError, *DuplicateEdgeError, *DanglingFaceError,
+// *MissingEdgeError, *DegenerateFaceError, *NonManifoldEdgeError or *UnusedVertexError is returned.
 func NewPolyhedron(vertices []Vertex, edges []Edge, faces []Face) (*Polyhedron, error) {
-	poly := Polyhedron{vertices: vertices}
+	if err := validate(vertices, edges, faces); err != nil {
+		return nil, err
+	}
+	poly := Polyhedron{vertices: append([]Vertex(nil), vertices...)}
+	poly.positions = make(map[Vertex]r3.Point, len(vertices))
+	for _, v := range vertices {
+		poly.positions[v] = r3.Point{X: 0, Y: 0, Z: 0}
+		if v > poly.lastVertex {
+			poly.lastVertex = v
+		}
+	}
 	poly.setFaces(faces)
 	poly.vertexNeighbors = make(map[Vertex][]Vertex)
 	poly.addEdges(edges)
@@ -11,18 +34,60 @@ func NewPolyhedron(vertices []Vertex, edges []Edge, faces []Face) (*Polyhedron,
 }
 
 // Polyhedron represents a Polyhedron consisting of vertices, edges and faces.
+// Each Polyhedron owns the positions of its vertices.
 type Polyhedron struct {
 	faces    []Face
 	vertices []Vertex
 
+	positions  map[Vertex]r3.Point
+	lastVertex Vertex
+
 	vertexNeighbors map[Vertex][]Vertex
 	edgeCache       []Edge
+	edgeCacheValid  bool
 
 	edgeToFace map[Edge][]Face
 }
 
-// init initialises the polyhedrons access caches.
+// newPolyhedronFromLoops creates a Polyhedron with vertices at the given positions and faces given as loops of indices
+// into the positions. The edges are derived from the faces. Faces are wound counter clockwise when seen from outside,
+// which assumes that the Polyhedron is convex and contains the origin.
+func newPolyhedronFromLoops(positions []r3.Point, loops [][]int) Polyhedron {
+	p := Polyhedron{}
+	p.init()
+	vertices := make([]Vertex, len(positions))
+	for i, pos := range positions {
+		vertices[i] = p.newVertex(pos)
+	}
+
+	edges := make([]Edge, 0)
+	edgeSet := make(map[Edge]bool)
+	for _, indices := range loops {
+		loop := make([]Vertex, len(indices))
+		for i, index := range indices {
+			loop[i] = vertices[index]
+		}
+		if faceNormal(&p, NewFace(loop)).Dot(origin.VectorTo(p.vertexCentroid(loop))) < 0 {
+			for i, j := 0, len(loop)-1; i < j; i, j = i+1, j-1 {
+				loop[i], loop[j] = loop[j], loop[i]
+			}
+		}
+		f := NewFace(loop)
+		for _, e := range f.Edges() {
+			if !edgeSet[e] {
+				edgeSet[e] = true
+				edges = append(edges, e)
+			}
+		}
+		p.addFace(f)
+	}
+	p.setEdges(edges)
+	return p
+}
+
+// init initialises the polyhedrons vertex storage and access caches.
 func (p *Polyhedron) init() {
+	p.positions = make(map[Vertex]r3.Point)
 	p.vertexNeighbors = make(map[Vertex][]Vertex)
 	p.edgeToFace = make(map[Edge][]Face)
 }
@@ -34,24 +99,31 @@ func (p *Polyhedron) Vertices() []Vertex {
 
 // Edges returns the polyhedrons edges.
 func (p *Polyhedron) Edges() []Edge {
-	if len(p.edgeCache) == 0 {
-		edges := make([]Edge, 0)
-		for _, v := range p.vertices {
-			vns := p.vertexNeighbors[v]
-			for _, vn := range vns {
-				edges = append(edges, NewEdge(v, vn))
-			}
-		}
-		p.edgeCache = cullDuplicates(edges)
+	if !p.edgeCacheValid {
+		p.updateEdgeCache()
 	}
 	return p.edgeCache
 }
 
+// updateEdgeCache recomputes the cache that contains the polyhedrons edges.
+// Constructors call this once they are done adding edges, so that reading the edges later does not write to the
+// Polyhedron.
+func (p *Polyhedron) updateEdgeCache() {
+	edges := make([]Edge, 0)
+	for _, v := range p.vertices {
+		vns := p.vertexNeighbors[v]
+		for _, vn := range vns {
+			edges = append(edges, NewEdge(v, vn))
+		}
+	}
+	p.edgeCache = cullDuplicates(edges)
+	p.edgeCacheValid = true
+}
+
 // resetEdge caches invalidates the cache that contains the polyhedrons edges.
 func (p *Polyhedron) resetEdgeCache() {
-	if len(p.edgeCache) > 0 {
-		p.edgeCache = make([]Edge, 0)
-	}
+	p.edgeCache = nil
+	p.edgeCacheValid = false
 }
 
 // Faces returns the polyhedrons faces.
@@ -92,6 +164,7 @@ func (p *Polyhedron) addEdges(edges []Edge) {
 			panic("Added illegal edge.")
 		}
 	}
+	p.updateEdgeCache()
 }
 
 // setEdges clears all current edges and adds the given edges instead.
@@ -195,3 +268,44 @@ func (p *Polyhedron) FaceVertexAdjacentFaces(f Face) []Face {
 func (p *Polyhedron) AdjacentVertices(vertex Vertex) []Vertex {
 	return p.vertexNeighbors[vertex]
 }
+
+// Clone returns a deep copy of the Polyhedron. Modifying the copy does not affect the original and vice versa.
+func (p *Polyhedron) Clone() *Polyhedron {
+	c := p.clone()
+	return &c
+}
+
+// clone returns a deep copy of the Polyhedron, including all of its caches.
+func (p *Polyhedron) clone() Polyhedron {
+	c := Polyhedron{
+		lastVertex:     p.lastVertex,
+		edgeCacheValid: p.edgeCacheValid,
+	}
+	c.vertices = append([]Vertex(nil), p.vertices...)
+	c.edgeCache = append([]Edge(nil), p.edgeCache...)
+
+	c.positions = make(map[Vertex]r3.Point, len(p.positions))
+	for v, pos := range p.positions {
+		c.positions[v] = pos
+	}
+
+	c.vertexNeighbors = make(map[Vertex][]Vertex, len(p.vertexNeighbors))
+	for v, neighbors := range p.vertexNeighbors {
+		c.vertexNeighbors[v] = append([]Vertex(nil), neighbors...)
+	}
+
+	c.faces = make([]Face, len(p.faces))
+	for i, f := range p.faces {
+		c.faces[i] = f.clone()
+	}
+
+	c.edgeToFace = make(map[Edge][]Face, len(p.edgeToFace))
+	for e, faces := range p.edgeToFace {
+		clonedFaces := make([]Face, len(faces))
+		for i, f := range faces {
+			clonedFaces[i] = f.clone()
+		}
+		c.edgeToFace[e] = clonedFaces
+	}
+	return c
+}
@@ -1 +1,101 @@
 package polyhedra
+
+import (
+	"testing"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// tetrahedronTopology returns the vertices, edges and faces of a tetrahedron.
+func tetrahedronTopology() ([]Vertex, []Edge, []Face) {
+	vertices := []Vertex{1, 2, 3, 4}
+	edges := []Edge{
+		NewEdge(1, 2), NewEdge(1, 3), NewEdge(1, 4),
+		NewEdge(2, 3), NewEdge(2, 4), NewEdge(3, 4),
+	}
+	faces := []Face{
+		NewFace([]Vertex{1, 2, 3}),
+		NewFace([]Vertex{1, 4, 2}),
+		NewFace([]Vertex{1, 3, 4}),
+		NewFace([]Vertex{2, 4, 3}),
+	}
+	return vertices, edges, faces
+}
+
+func TestPolyhedronVertexPositions(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	p1, _ := NewPolyhedron(vertices, edges, faces)
+	p2, _ := NewPolyhedron(vertices, edges, faces)
+
+	p1.SetVertexPosition(1, r3.Point{X: 1, Y: 0, Z: 0})
+	p2.SetVertexPosition(1, r3.Point{X: 0, Y: 2, Z: 0})
+
+	if p1.VertexPosition(1) != (r3.Point{X: 1, Y: 0, Z: 0}) {
+		t.Errorf("Vertex 1 of the first polyhedron is at %v", p1.VertexPosition(1))
+	}
+	if p2.VertexPosition(1) != (r3.Point{X: 0, Y: 2, Z: 0}) {
+		t.Errorf("Vertex 1 of the second polyhedron is at %v", p2.VertexPosition(1))
+	}
+	if p1.EdgeLength(NewEdge(1, 2)) != 1 {
+		t.Errorf("Edge length is %v instead of 1", p1.EdgeLength(NewEdge(1, 2)))
+	}
+}
+
+func TestPolyhedronVertexIDsAreScoped(t *testing.T) {
+	ico1 := NewIcosahedron()
+	ico2 := NewIcosahedron()
+	for i, v := range ico1.Vertices() {
+		if v != ico2.Vertices()[i] {
+			t.Errorf("Expected vertex %v to have the same id in both icosahedra but got %v and %v", i, v, ico2.Vertices()[i])
+		}
+	}
+}
+
+func TestNewPolyhedronCopiesVertices(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	p, _ := NewPolyhedron(vertices, edges, faces)
+	vertices[0] = 5
+	if p.Vertices()[0] != 1 {
+		t.Errorf("Changing the input changed the first vertex to %v", p.Vertices()[0])
+	}
+}
+
+func TestConcurrentConstruction(t *testing.T) {
+	shared := NewIcosahedralGeodesic()
+	if err := shared.Subdivide(2, 1); err != nil {
+		t.Fatalf("Legal subdivision failed: %v", err)
+	}
+
+	for i := 0; i < 8; i++ {
+		t.Run("worker", func(t *testing.T) {
+			t.Parallel()
+
+			ico := NewIcosahedron()
+			assertFaceCount(ico, 20, t)
+
+			gg := NewIcosahedralGeodesic()
+			if err := gg.Subdivide(3, 0, WithProjection(SphericalProjection)); err != nil {
+				t.Fatalf("Legal subdivision failed: %v", err)
+			}
+			assertFaceCount(gg, 20*9, t)
+
+			gp, err := GeodesicToGoldberg(gg)
+			if err != nil {
+				t.Fatalf("Conversion to Goldberg polyhedron failed: %v", err)
+			}
+			assertFaceCount(gp, 10*9+2, t)
+
+			sharedGp, err := GeodesicToGoldberg(shared)
+			if err != nil {
+				t.Fatalf("Conversion to Goldberg polyhedron failed: %v", err)
+			}
+			assertFaceCount(sharedGp, 10*7+2, t)
+
+			igp, err := NewIcosahedralGoldbergPolyhedron(2, 2)
+			if err != nil {
+				t.Fatalf("Creation of Goldberg polyhedron failed: %v", err)
+			}
+			assertFaceCount(igp, 10*12+2, t)
+		})
+	}
+}
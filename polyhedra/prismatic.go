@@ -0,0 +1,148 @@
+package polyhedra
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// The prismatic families are built around the z axis from regular polygons with unit edge length. Their vertices
+// are centered on the origin.
+
+// regularPolygon returns the corners of the regular polygon with n sides of unit length in the plane at height z,
+// counter clockwise around the z axis. The first corner lies at the given angle.
+func regularPolygon(n int, z, angle float64) []r3.Point {
+	r := polygonCircumradius(n)
+	corners := make([]r3.Point, n)
+	for i := range corners {
+		a := angle + 2*math.Pi*float64(i)/float64(n)
+		corners[i] = r3.Point{X: r * math.Cos(a), Y: r * math.Sin(a), Z: z}
+	}
+	return corners
+}
+
+// polygonCircumradius returns the circumradius of the regular polygon with n sides of unit length.
+func polygonCircumradius(n int) float64 {
+	return 1 / (2 * math.Sin(math.Pi/float64(n)))
+}
+
+// apexHeight returns the height of the apex of a pyramid over a regular polygon with n sides of unit length.
+// The lateral edges have unit length as well where that is possible, which is the case for n < 6. Otherwise the
+// apex is placed at a height of 1.
+func apexHeight(n int) float64 {
+	r := polygonCircumradius(n)
+	if r >= 1 {
+		return 1
+	}
+	return math.Sqrt(1 - r*r)
+}
+
+// checkPolygonSides returns an error if a Polyhedron cannot be built from regular polygons with n sides.
+func checkPolygonSides(n int) error {
+	if n < 3 {
+		return fmt.Errorf("a polygon needs at least 3 sides, got %v", n)
+	}
+	return nil
+}
+
+// newCenteredPolyhedron creates a Polyhedron from the given positions and loops after moving the centroid of the
+// positions to the origin.
+func newCenteredPolyhedron(positions []r3.Point, loops [][]int) *Polyhedron {
+	shift := r3.Centroid3D(positions).VectorTo(origin)
+	for i := range positions {
+		positions[i] = positions[i].Add(shift)
+	}
+	poly := newPolyhedronFromLoops(positions, loops)
+	return &poly
+}
+
+// NewPrism creates a uniform prism with two regular n-gons and n squares as faces. All edges have unit length.
+// It returns an error if n < 3.
+func NewPrism(n int) (*Polyhedron, error) {
+	if err := checkPolygonSides(n); err != nil {
+		return nil, err
+	}
+	return newPrism(n), nil
+}
+
+// newPrism creates the prism with n sides without checking n, see NewPrism.
+func newPrism(n int) *Polyhedron {
+	positions := append(regularPolygon(n, -0.5, 0), regularPolygon(n, 0.5, 0)...)
+	bottom, top := make([]int, n), make([]int, n)
+	loops := make([][]int, 0, n+2)
+	for i := 0; i < n; i++ {
+		next := (i + 1) % n
+		bottom[i], top[i] = i, n+i
+		loops = append(loops, []int{i, next, n + next, n + i})
+	}
+	return newCenteredPolyhedron(positions, append(loops, bottom, top))
+}
+
+// NewAntiprism creates a uniform antiprism with two regular n-gons and 2n equilateral triangles as faces. All edges
+// have unit length. It returns an error if n < 3.
+func NewAntiprism(n int) (*Polyhedron, error) {
+	if err := checkPolygonSides(n); err != nil {
+		return nil, err
+	}
+	return newAntiprism(n), nil
+}
+
+// newAntiprism creates the antiprism with n sides without checking n, see NewAntiprism.
+func newAntiprism(n int) *Polyhedron {
+	r := polygonCircumradius(n)
+	height := math.Sqrt(1 - 2*r*r*(1-math.Cos(math.Pi/float64(n))))
+	positions := append(regularPolygon(n, -height/2, 0), regularPolygon(n, height/2, math.Pi/float64(n))...)
+	bottom, top := make([]int, n), make([]int, n)
+	loops := make([][]int, 0, 2*n+2)
+	for i := 0; i < n; i++ {
+		next := (i + 1) % n
+		bottom[i], top[i] = i, n+i
+		loops = append(loops, []int{i, next, n + i}, []int{next, n + next, n + i})
+	}
+	return newCenteredPolyhedron(positions, append(loops, bottom, top))
+}
+
+// NewPyramid creates a pyramid with a regular n-gon as base and n isosceles triangles as lateral faces. The edges of
+// the base have unit length. For n < 6 the lateral edges have unit length as well, otherwise the apex lies at a
+// height of 1 over the base. It returns an error if n < 3.
+func NewPyramid(n int) (*Polyhedron, error) {
+	if err := checkPolygonSides(n); err != nil {
+		return nil, err
+	}
+	return newPyramid(n), nil
+}
+
+// newPyramid creates the pyramid with n sides without checking n, see NewPyramid.
+func newPyramid(n int) *Polyhedron {
+	positions := append(regularPolygon(n, 0, 0), r3.Point{X: 0, Y: 0, Z: apexHeight(n)})
+	base := make([]int, n)
+	loops := make([][]int, 0, n+1)
+	for i := 0; i < n; i++ {
+		base[i] = i
+		loops = append(loops, []int{i, (i + 1) % n, n})
+	}
+	return newCenteredPolyhedron(positions, append(loops, base))
+}
+
+// NewBipyramid creates a bipyramid from two pyramids joined at their regular n-gon bases, which results in 2n
+// isosceles triangles as faces. The edges around the equator have unit length. For n < 6 all other edges have unit
+// length as well, otherwise the apexes lie at a distance of 1 from the equator. It returns an error if n < 3.
+func NewBipyramid(n int) (*Polyhedron, error) {
+	if err := checkPolygonSides(n); err != nil {
+		return nil, err
+	}
+	return newBipyramid(n), nil
+}
+
+// newBipyramid creates the bipyramid with n sides without checking n, see NewBipyramid.
+func newBipyramid(n int) *Polyhedron {
+	h := apexHeight(n)
+	positions := append(regularPolygon(n, 0, 0), r3.Point{X: 0, Y: 0, Z: h}, r3.Point{X: 0, Y: 0, Z: -h})
+	loops := make([][]int, 0, 2*n)
+	for i := 0; i < n; i++ {
+		next := (i + 1) % n
+		loops = append(loops, []int{i, next, n}, []int{next, i, n + 1})
+	}
+	return newCenteredPolyhedron(positions, loops)
+}
@@ -0,0 +1,104 @@
+package polyhedra
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// sortedDistances returns the distances between all pairs of vertices, which are the same for congruent polyhedra.
+func sortedDistances(p Interface) []float64 {
+	vertices := p.Vertices()
+	distances := make([]float64, 0, len(vertices)*len(vertices)/2)
+	for i, v := range vertices {
+		for _, w := range vertices[i+1:] {
+			distances = append(distances, r3.Distance(p.VertexPosition(v), p.VertexPosition(w)))
+		}
+	}
+	sort.Float64s(distances)
+	return distances
+}
+
+func assertCongruent(p, q Interface, t *testing.T) {
+	dp, dq := sortedDistances(p), sortedDistances(q)
+	if len(dp) != len(dq) {
+		t.Fatalf("Polyhedra have %v and %v vertices", len(p.Vertices()), len(q.Vertices()))
+	}
+	for i := range dp {
+		if math.Abs(dp[i]-dq[i]) > 1e-9 {
+			t.Fatalf("Polyhedra are not congruent, vertex distances %v and %v differ", dp[i], dq[i])
+		}
+	}
+	assertEdgeCount(p, len(q.Edges()), t)
+	assertFaceCount(p, len(q.Faces()), t)
+}
+
+func TestPrismaticFamilies(t *testing.T) {
+	families := []struct {
+		name   string
+		create func(n int) (*Polyhedron, error)
+		// counts returns the number of vertices, edges and faces for n.
+		counts func(n int) (int, int, int)
+		// regular marks families whose edges all have unit length for the given n.
+		regular func(n int) bool
+	}{
+		{"prism", NewPrism, func(n int) (int, int, int) { return 2 * n, 3 * n, n + 2 }, func(int) bool { return true }},
+		{"antiprism", NewAntiprism, func(n int) (int, int, int) { return 2 * n, 4 * n, 2*n + 2 }, func(int) bool { return true }},
+		{"pyramid", NewPyramid, func(n int) (int, int, int) { return n + 1, 2 * n, n + 1 }, func(n int) bool { return n < 6 }},
+		{"bipyramid", NewBipyramid, func(n int) (int, int, int) { return n + 2, 3 * n, 2 * n }, func(n int) bool { return n < 6 }},
+	}
+	for _, family := range families {
+		for n := 3; n <= 9; n++ {
+			p, err := family.create(n)
+			if err != nil {
+				t.Fatalf("Creating the %v-%v failed: %v", family.name, n, err)
+			}
+			vertices, edges, faces := family.counts(n)
+			assertVertexCount(p, vertices, t)
+			assertEdgeCount(p, edges, t)
+			assertFaceCount(p, faces, t)
+			assertOutwardWinding(p, t)
+			if family.regular(n) {
+				assertRegularEdges(p, t)
+				if math.Abs(p.EdgeLength(p.Edges()[0])-1) > 1e-9 {
+					t.Errorf("The %v-%v has edges of length %v", family.name, n, p.EdgeLength(p.Edges()[0]))
+				}
+			}
+			if issues := NewIntegrityChecker().CheckIntegrity(p); len(issues) != 0 {
+				t.Errorf("The %v-%v has integrity issues: %v", family.name, n, issues)
+			}
+		}
+	}
+}
+
+func TestPrismaticFamiliesRejectFewSides(t *testing.T) {
+	for _, create := range []func(int) (*Polyhedron, error){NewPrism, NewAntiprism, NewPyramid, NewBipyramid} {
+		for _, n := range []int{-1, 0, 2} {
+			if p, err := create(n); err == nil || p != nil {
+				t.Errorf("Expected an error for %v sides but got %v", n, p)
+			}
+		}
+	}
+}
+
+func TestPrismaticFamiliesMatchPlatonicSolids(t *testing.T) {
+	assertCongruent(newPrism(4), NewCube(math.Sqrt(3)/2), t)
+	assertCongruent(newAntiprism(3), NewOctahedron(math.Sqrt(0.5)), t)
+	assertCongruent(newPyramid(3), NewTetrahedron(math.Sqrt(3.0/8)), t)
+	assertCongruent(newBipyramid(4), NewOctahedron(math.Sqrt(0.5)), t)
+}
+
+func TestPrismFaceEdgeAdjacentFaces(t *testing.T) {
+	prism := newPrism(6)
+	for _, f := range prism.Faces() {
+		expected := 4
+		if len(f.Loop()) == 6 {
+			expected = 6
+		}
+		if n := len(prism.FaceEdgeAdjacentFaces(f)); n != expected {
+			t.Errorf("Face %v has %v edge adjacent faces instead of %v", f.String(), n, expected)
+		}
+	}
+}
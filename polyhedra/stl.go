@@ -0,0 +1,96 @@
+package polyhedra
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+// stlHeader is the start of the 80 byte header of binary STL files. It must not start with "solid", which marks
+// ASCII files.
+const stlHeader = "binary STL written by polyhedra"
+
+// WriteSTL writes the Polyhedron to w in the STL format with the given encoding. Faces with more than three vertices
+// are split into a fan of triangles around their first vertex. The facet normal of every triangle is the normal of
+// the triangle itself, which differs from the normal of its Face if the Face is not planar. It points outwards for
+// faces that are wound counter clockwise when seen from outside.
+func WriteSTL(w io.Writer, p Interface, encoding Encoding) error {
+	if err := checkEncoding(encoding); err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	if encoding == BinaryEncoding {
+		writeBinarySTL(bw, p)
+	} else {
+		writeASCIISTL(bw, p)
+	}
+	return bw.Flush()
+}
+
+// triangleNormal returns the unit normal of the triangle of the Face, which points to the side from which the
+// triangle is wound counter clockwise. Degenerate triangles get the normal of the Face.
+func triangleNormal(p Interface, f Face, triangle [3]Vertex) r3.Vector {
+	a := p.VertexPosition(triangle[0])
+	n := a.VectorTo(p.VertexPosition(triangle[1])).Cross(a.VectorTo(p.VertexPosition(triangle[2])))
+	if n.Length() == 0 {
+		return faceNormal(p, f)
+	}
+	return n.Normalised()
+}
+
+// writeASCIISTL writes the triangles of the Polyhedron as text.
+func writeASCIISTL(w *bufio.Writer, p Interface) {
+	fmt.Fprintln(w, "solid polyhedron")
+	for _, f := range p.Faces() {
+		for _, triangle := range fanTriangles(f) {
+			n := triangleNormal(p, f, triangle)
+			fmt.Fprintf(w, "  facet normal %e %e %e\n", n.X, n.Y, n.Z)
+			fmt.Fprintln(w, "    outer loop")
+			for _, v := range triangle {
+				pos := p.VertexPosition(v)
+				fmt.Fprintf(w, "      vertex %e %e %e\n", pos.X, pos.Y, pos.Z)
+			}
+			fmt.Fprintln(w, "    endloop")
+			fmt.Fprintln(w, "  endfacet")
+		}
+	}
+	fmt.Fprintln(w, "endsolid polyhedron")
+}
+
+// writeBinarySTL writes the 80 byte header, the number of triangles and 50 bytes for every triangle: the normal and
+// the three vertices as float32 triples followed by an unused uint16 attribute.
+func writeBinarySTL(w *bufio.Writer, p Interface) {
+	var header [80]byte
+	copy(header[:], stlHeader)
+	w.Write(header[:])
+
+	count := 0
+	for _, f := range p.Faces() {
+		count += len(f.Loop()) - 2
+	}
+	var buf [50]byte
+	binary.LittleEndian.PutUint32(buf[:4], uint32(count))
+	w.Write(buf[:4])
+
+	put := func(offset int, x, y, z float64) {
+		binary.LittleEndian.PutUint32(buf[offset:], math.Float32bits(float32(x)))
+		binary.LittleEndian.PutUint32(buf[offset+4:], math.Float32bits(float32(y)))
+		binary.LittleEndian.PutUint32(buf[offset+8:], math.Float32bits(float32(z)))
+	}
+	for _, f := range p.Faces() {
+		for _, triangle := range fanTriangles(f) {
+			n := triangleNormal(p, f, triangle)
+			put(0, n.X, n.Y, n.Z)
+			for i, v := range triangle {
+				pos := p.VertexPosition(v)
+				put(12*(i+1), pos.X, pos.Y, pos.Z)
+			}
+			binary.LittleEndian.PutUint16(buf[48:], 0)
+			w.Write(buf[:])
+		}
+	}
+}
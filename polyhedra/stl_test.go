@@ -0,0 +1,117 @@
+package polyhedra
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/MichaelMauderer/polyhedra/r3"
+)
+
+func TestWriteASCIISTL(t *testing.T) {
+	p, err := NewIcosahedralGoldbergPolyhedron(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteSTL(&buf, p, ASCIIEncoding); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.HasPrefix(out, "solid ") || !strings.HasSuffix(out, "endsolid polyhedron\n") {
+		t.Errorf("Output is not enclosed in solid and endsolid")
+	}
+	// The 12 pentagons become 3 triangles each.
+	if facets := strings.Count(out, "facet normal"); facets != 36 {
+		t.Errorf("Expected 36 facets, got %v", facets)
+	}
+	if vertices := strings.Count(out, "vertex "); vertices != 3*36 {
+		t.Errorf("Expected %v vertex lines, got %v", 3*36, vertices)
+	}
+}
+
+func TestWriteBinarySTL(t *testing.T) {
+	p, err := NewIcosahedralGoldbergPolyhedron(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteSTL(&buf, p, BinaryEncoding); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	if strings.HasPrefix(string(data), "solid") {
+		t.Error("Binary STL must not start with solid")
+	}
+	count := int(binary.LittleEndian.Uint32(data[80:84]))
+	if expected := 12*3 + 20*4; count != expected {
+		t.Fatalf("Expected %v triangles, got %v", expected, count)
+	}
+	if len(data) != 84+50*count {
+		t.Fatalf("Expected %v bytes, got %v", 84+50*count, len(data))
+	}
+
+	read := func(offset int) r3.Vector {
+		return r3.Vector{
+			X: float64(math.Float32frombits(binary.LittleEndian.Uint32(data[offset:]))),
+			Y: float64(math.Float32frombits(binary.LittleEndian.Uint32(data[offset+4:]))),
+			Z: float64(math.Float32frombits(binary.LittleEndian.Uint32(data[offset+8:]))),
+		}
+	}
+	for i := 0; i < count; i++ {
+		offset := 84 + 50*i
+		normal := read(offset)
+		a, b, c := read(offset+12), read(offset+24), read(offset+36)
+		if math.Abs(normal.Length()-1) > 1e-6 {
+			t.Errorf("Normal of triangle %v has length %v", i, normal.Length())
+		}
+		if normal.Dot(a) <= 0 {
+			t.Errorf("Normal of triangle %v points inwards", i)
+		}
+		winding := b.Add(a.Scale(-1)).Cross(c.Add(a.Scale(-1)))
+		if winding.Dot(normal) <= 0 {
+			t.Errorf("Triangle %v is not wound counter clockwise around its normal", i)
+		}
+	}
+}
+
+func TestWriteSTLNonPlanarFaces(t *testing.T) {
+	// The centroid dual of J84 has faces that are not planar.
+	p, err := Dual(johnsonSolid(84, t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteSTL(&buf, p, BinaryEncoding); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	read := func(offset int) r3.Vector {
+		return r3.Vector{
+			X: float64(math.Float32frombits(binary.LittleEndian.Uint32(data[offset:]))),
+			Y: float64(math.Float32frombits(binary.LittleEndian.Uint32(data[offset+4:]))),
+			Z: float64(math.Float32frombits(binary.LittleEndian.Uint32(data[offset+8:]))),
+		}
+	}
+	count := int(binary.LittleEndian.Uint32(data[80:84]))
+	for i := 0; i < count; i++ {
+		offset := 84 + 50*i
+		a, b, c := read(offset+12), read(offset+24), read(offset+36)
+		expected := b.Add(a.Scale(-1)).Cross(c.Add(a.Scale(-1))).Normalised()
+		if d := read(offset).Add(expected.Scale(-1)).Length(); d > 1e-5 {
+			t.Errorf("Normal of triangle %v deviates by %v from the normal of the triangle", i, d)
+		}
+	}
+}
+
+func TestWriteSTLUnknownEncoding(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteSTL(&buf, NewCube(1), Encoding(2)); err == nil {
+		t.Error("Expected an error for an unknown encoding")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Wrote %v bytes for an unknown encoding", buf.Len())
+	}
+}
@@ -0,0 +1,189 @@
+package polyhedra
+
+import (
+	"fmt"
+)
+
+// EmptyPolyhedronError is returned when there are no vertices or no faces.
+type EmptyPolyhedronError struct{}
+
+func (e *EmptyPolyhedronError) Error() string {
+	return "polyhedron has no vertices or no faces"
+}
+
+// DuplicateVertexError is returned when a Vertex is given more than once.
+type DuplicateVertexError struct {
+	Vertex Vertex
+}
+
+func (e *DuplicateVertexError) Error() string {
+	return fmt.Sprintf("vertex %v is given more than once", e.Vertex)
+}
+
+// UnusedVertexError is returned when a Vertex is not part of any Face.
+type UnusedVertexError struct {
+	Vertex Vertex
+}
+
+func (e *UnusedVertexError) Error() string {
+	return fmt.Sprintf("vertex %v is not part of any face", e.Vertex)
+}
+
+// DanglingEdgeError is returned when an Edge refers to a Vertex that is not part of the Polyhedron.
+type DanglingEdgeError struct {
+	Edge   Edge
+	Vertex Vertex
+}
+
+func (e *DanglingEdgeError) Error() string {
+	return fmt.Sprintf("edge %v refers to unknown vertex %v", e.Edge, e.Vertex)
+}
+
+// SelfLoopError is returned when an Edge connects a Vertex with itself.
+type SelfLoopError struct {
+	Edge Edge
+}
+
+func (e *SelfLoopError) Error() string {
+	return fmt.Sprintf("edge %v is a self-loop", e.Edge)
+}
+
+// DuplicateEdgeError is returned when an Edge is given more than once.
+type DuplicateEdgeError struct {
+	Edge Edge
+}
+
+func (e *DuplicateEdgeError) Error() string {
+	return fmt.Sprintf("edge %v is given more than once", e.Edge)
+}
+
+// DanglingFaceError is returned when a Face refers to a Vertex that is not part of the Polyhedron.
+type DanglingFaceError struct {
+	Face   Face
+	Vertex Vertex
+}
+
+func (e *DanglingFaceError) Error() string {
+	return fmt.Sprintf("face %v refers to unknown vertex %v", e.Face.String(), e.Vertex)
+}
+
+// MissingEdgeError is returned when a Face contains an Edge that is not part of the Polyhedron.
+type MissingEdgeError struct {
+	Face Face
+	Edge Edge
+}
+
+func (e *MissingEdgeError) Error() string {
+	return fmt.Sprintf("face %v contains unknown edge %v", e.Face.String(), e.Edge)
+}
+
+// DegenerateFaceError is returned when a Face has fewer than three vertices or contains a Vertex more than once.
+type DegenerateFaceError struct {
+	Face Face
+}
+
+func (e *DegenerateFaceError) Error() string {
+	return fmt.Sprintf("face %v is degenerate", e.Face.String())
+}
+
+// NonManifoldEdgeError is returned when an Edge is not shared by exactly two faces.
+// Edges with fewer faces leave a hole in the surface, edges with more faces join more than two surface sheets.
+type NonManifoldEdgeError struct {
+	Edge  Edge
+	Faces int
+}
+
+func (e *NonManifoldEdgeError) Error() string {
+	return fmt.Sprintf("edge %v is shared by %v faces instead of 2", e.Edge, e.Faces)
+}
+
+// validate checks that the given vertices, edges and faces describe a closed manifold Polyhedron.
+// The first violation that is found is returned.
+func validate(vertices []Vertex, edges []Edge, faces []Face) error {
+	if len(vertices) == 0 || len(faces) == 0 {
+		return &EmptyPolyhedronError{}
+	}
+	vertexSet := make(map[Vertex]bool, len(vertices))
+	for _, v := range vertices {
+		if vertexSet[v] {
+			return &DuplicateVertexError{v}
+		}
+		vertexSet[v] = true
+	}
+
+	edgeFaces := make(map[Edge]int, len(edges))
+	for _, e := range edges {
+		if e.v1 == e.v2 {
+			return &SelfLoopError{e}
+		}
+		for _, v := range e.Vertices() {
+			if !vertexSet[v] {
+				return &DanglingEdgeError{e, v}
+			}
+		}
+		ne := NewEdge(e.v1, e.v2)
+		if _, ok := edgeFaces[ne]; ok {
+			return &DuplicateEdgeError{e}
+		}
+		edgeFaces[ne] = 0
+	}
+
+	usedVertices := make(map[Vertex]bool, len(vertices))
+	for _, f := range faces {
+		loopSet := make(map[Vertex]bool, len(f.loop))
+		for _, v := range f.loop {
+			if loopSet[v] {
+				return &DegenerateFaceError{f}
+			}
+			loopSet[v] = true
+		}
+		if len(f.loop) < 3 {
+			return &DegenerateFaceError{f}
+		}
+		for _, v := range f.loop {
+			if !vertexSet[v] {
+				return &DanglingFaceError{f, v}
+			}
+			usedVertices[v] = true
+		}
+		for _, e := range f.edges {
+			if _, ok := edgeFaces[e]; !ok {
+				return &MissingEdgeError{f, e}
+			}
+			edgeFaces[e]++
+		}
+	}
+
+	for _, e := range edges {
+		ne := NewEdge(e.v1, e.v2)
+		if edgeFaces[ne] != 2 {
+			return &NonManifoldEdgeError{e, edgeFaces[ne]}
+		}
+	}
+	for _, v := range vertices {
+		if !usedVertices[v] {
+			return &UnusedVertexError{v}
+		}
+	}
+	return nil
+}
+
+// InconsistentWindingError is returned when the two faces at an Edge traverse it in the same direction, so that the
+// faces are not wound consistently.
+type InconsistentWindingError struct {
+	Edge Edge
+}
+
+func (e *InconsistentWindingError) Error() string {
+	return fmt.Sprintf("faces at edge %v are not wound consistently", e.Edge)
+}
+
+// NonManifoldVertexError is returned when the faces around a Vertex do not form a single fan, for example because
+// two cones touch at their apex.
+type NonManifoldVertexError struct {
+	Vertex Vertex
+}
+
+func (e *NonManifoldVertexError) Error() string {
+	return fmt.Sprintf("faces around vertex %v do not form a single fan", e.Vertex)
+}
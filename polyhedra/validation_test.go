@@ -0,0 +1,151 @@
+package polyhedra
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewPolyhedronValid(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	p, err := NewPolyhedron(vertices, edges, faces)
+	if err != nil {
+		t.Fatalf("Valid tetrahedron was rejected: %v", err)
+	}
+	assertVertexCount(p, 4, t)
+	assertEdgeCount(p, 6, t)
+	assertFaceCount(p, 4, t)
+	for _, e := range p.Edges() {
+		fs := p.EdgeAdjacentFaces(e)
+		if fs[0].Equals(fs[1]) {
+			t.Errorf("Edge %v is adjacent to the same face twice", e)
+		}
+	}
+}
+
+func TestNewPolyhedronDanglingEdge(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	edges = append(edges, NewEdge(1, 5))
+	_, err := NewPolyhedron(vertices, edges, faces)
+	var dErr *DanglingEdgeError
+	if !errors.As(err, &dErr) {
+		t.Fatalf("Expected DanglingEdgeError but got %v", err)
+	}
+	if dErr.Vertex != 5 {
+		t.Errorf("Expected dangling vertex 5 but got %v", dErr.Vertex)
+	}
+}
+
+func TestNewPolyhedronSelfLoop(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	edges = append(edges, Edge{2, 2})
+	_, err := NewPolyhedron(vertices, edges, faces)
+	var sErr *SelfLoopError
+	if !errors.As(err, &sErr) {
+		t.Fatalf("Expected SelfLoopError but got %v", err)
+	}
+}
+
+func TestNewPolyhedronDuplicateEdge(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	edges = append(edges, Edge{2, 1})
+	_, err := NewPolyhedron(vertices, edges, faces)
+	var dErr *DuplicateEdgeError
+	if !errors.As(err, &dErr) {
+		t.Fatalf("Expected DuplicateEdgeError but got %v", err)
+	}
+}
+
+func TestNewPolyhedronMissingEdge(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	_, err := NewPolyhedron(vertices, edges[1:], faces)
+	var mErr *MissingEdgeError
+	if !errors.As(err, &mErr) {
+		t.Fatalf("Expected MissingEdgeError but got %v", err)
+	}
+	if mErr.Edge != edges[0] {
+		t.Errorf("Expected missing edge %v but got %v", edges[0], mErr.Edge)
+	}
+}
+
+func TestNewPolyhedronDanglingFace(t *testing.T) {
+	vertices, _, faces := tetrahedronTopology()
+	_, err := NewPolyhedron(vertices[:3], []Edge{NewEdge(1, 2), NewEdge(2, 3), NewEdge(1, 3)}, faces)
+	var fErr *DanglingFaceError
+	if !errors.As(err, &fErr) {
+		t.Fatalf("Expected DanglingFaceError but got %v", err)
+	}
+	if fErr.Vertex != 4 {
+		t.Errorf("Expected dangling vertex 4 but got %v", fErr.Vertex)
+	}
+}
+
+func TestNewPolyhedronDegenerateFace(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	_, err := NewPolyhedron(vertices, edges, append(faces, NewFace([]Vertex{1, 2})))
+	var dErr *DegenerateFaceError
+	if !errors.As(err, &dErr) {
+		t.Fatalf("Expected DegenerateFaceError but got %v", err)
+	}
+	_, err = NewPolyhedron(vertices, edges, append(faces, NewFace([]Vertex{1, 2, 1, 3})))
+	if !errors.As(err, &dErr) {
+		t.Fatalf("Expected DegenerateFaceError but got %v", err)
+	}
+}
+
+func TestNewPolyhedronNonManifoldEdge(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+
+	_, err := NewPolyhedron(vertices, edges, faces[1:])
+	var nErr *NonManifoldEdgeError
+	if !errors.As(err, &nErr) {
+		t.Fatalf("Expected NonManifoldEdgeError for an open surface but got %v", err)
+	}
+	if nErr.Faces != 1 {
+		t.Errorf("Expected edge shared by 1 face but got %v", nErr.Faces)
+	}
+
+	vertices = append(vertices, 5)
+	edges = append(edges, NewEdge(1, 5), NewEdge(2, 5), NewEdge(3, 5))
+	faces = append(faces, NewFace([]Vertex{1, 2, 5}), NewFace([]Vertex{2, 3, 5}), NewFace([]Vertex{3, 1, 5}))
+	_, err = NewPolyhedron(vertices, edges, faces)
+	if !errors.As(err, &nErr) {
+		t.Fatalf("Expected NonManifoldEdgeError but got %v", err)
+	}
+	if nErr.Faces != 3 {
+		t.Errorf("Expected edge shared by 3 faces but got %v", nErr.Faces)
+	}
+}
+
+func TestNewPolyhedronEmpty(t *testing.T) {
+	var eErr *EmptyPolyhedronError
+	if _, err := NewPolyhedron(nil, nil, nil); !errors.As(err, &eErr) {
+		t.Errorf("Expected EmptyPolyhedronError but got %v", err)
+	}
+	if _, err := NewPolyhedron([]Vertex{1, 2, 3}, nil, nil); !errors.As(err, &eErr) {
+		t.Errorf("Expected EmptyPolyhedronError for vertices without faces but got %v", err)
+	}
+}
+
+func TestNewPolyhedronDuplicateVertex(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	_, err := NewPolyhedron(append(vertices, 3), edges, faces)
+	var dErr *DuplicateVertexError
+	if !errors.As(err, &dErr) {
+		t.Fatalf("Expected DuplicateVertexError but got %v", err)
+	}
+	if dErr.Vertex != 3 {
+		t.Errorf("Expected duplicate vertex 3 but got %v", dErr.Vertex)
+	}
+}
+
+func TestNewPolyhedronUnusedVertex(t *testing.T) {
+	vertices, edges, faces := tetrahedronTopology()
+	_, err := NewPolyhedron(append(vertices, 5), edges, faces)
+	var uErr *UnusedVertexError
+	if !errors.As(err, &uErr) {
+		t.Fatalf("Expected UnusedVertexError but got %v", err)
+	}
+	if uErr.Vertex != 5 {
+		t.Errorf("Expected unused vertex 5 but got %v", uErr.Vertex)
+	}
+}
@@ -6,39 +6,37 @@ import (
 )
 
 // Vertex represents a point within a Polyhedron where edges meet.
+// A Vertex is an id that is unique within its Polyhedron, its position is stored in the Polyhedron.
 type Vertex uint
 
-// vertexID is the global id counter that is used to generate unique ids fo vertices.
-var vertexID Vertex
-
-// vertexPositions contains the position for each vertex.
-var vertexPositions = make(map[Vertex]r3.Point)
-
-// NewVertex creates an new vertex.
-func NewVertex() Vertex {
-	vertexID++
-	return vertexID
+// String returns the string representation of the vertex.
+func (v Vertex) String() string {
+	return fmt.Sprintf("Vertex(id=%v)", uint(v))
 }
 
-// setPosition sets the position of the vertex.
-func (v Vertex) setPosition(coords r3.Point) {
-	vertexPositions[v] = coords
+// newVertex creates a new vertex at the given position and adds it to the Polyhedron.
+func (p *Polyhedron) newVertex(position r3.Point) Vertex {
+	p.lastVertex++
+	v := p.lastVertex
+	p.positions[v] = position
+	p.vertices = append(p.vertices, v)
+	return v
 }
 
-// Position returns the position of the vertex.
-func (v Vertex) Position() r3.Point {
-	return vertexPositions[v]
+// VertexPosition returns the position of the given vertex.
+func (p *Polyhedron) VertexPosition(v Vertex) r3.Point {
+	return p.positions[v]
 }
 
-// String returns the string representation of the vertex.
-func (v Vertex) String() string {
-	return fmt.Sprintf("Vertex(id=%v, pos=%v)", uint(v), v.Position())
+// SetVertexPosition sets the position of the given vertex.
+func (p *Polyhedron) SetVertexPosition(v Vertex, position r3.Point) {
+	p.positions[v] = position
 }
 
 // SortedClockwise sorts the vertices clockwise around their geometric center.
-func SortedClockwise(vertices []Vertex) []Vertex {
+func (p *Polyhedron) SortedClockwise(vertices []Vertex) []Vertex {
 	//Insertion sort based on clockwiseness
-	c := vertexCentroid(vertices)
+	c := p.vertexCentroid(vertices)
 	// The normal of the plane of sorting is defined by the vector from zero to the geometric center.
 	n := r3.Point{X: 0, Y: 0, Z: 0}.VectorTo(c).Normalised()
 	sorted := make([]Vertex, 1)
@@ -51,7 +49,7 @@ func SortedClockwise(vertices []Vertex) []Vertex {
 				break
 			}
 			vo := sorted[i]
-			if !r3.IsCCW(v.Position(), vo.Position(), c, n) {
+			if !r3.IsCCW(p.positions[v], p.positions[vo], c, n) {
 				break
 			}
 		}
@@ -64,10 +62,10 @@ func SortedClockwise(vertices []Vertex) []Vertex {
 }
 
 // vertexCentroid computes the centroid of the given vertices.
-func vertexCentroid(vertices []Vertex) r3.Point {
+func (p *Polyhedron) vertexCentroid(vertices []Vertex) r3.Point {
 	positions := make([]r3.Point, len(vertices))
 	for i, v := range vertices {
-		positions[i] = v.Position()
+		positions[i] = p.positions[v]
 	}
 	return r3.Centroid3D(positions)
 }
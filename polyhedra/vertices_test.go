@@ -25,14 +25,11 @@ func TestSortedClockwise(t *testing.T) {
 		{1, 1, 3},
 		{1, 2, 4},
 	}
-	vertices := []Vertex{
-		NewVertex(),
-		NewVertex(),
-		NewVertex(),
-		NewVertex(),
-	}
+	p := Polyhedron{}
+	p.init()
+	vertices := make([]Vertex, len(sortedPositions))
 	for i := range vertices {
-		vertices[i].setPosition(sortedPositions[i])
+		vertices[i] = p.newVertex(sortedPositions[i])
 	}
 
 	for i := 0; i < 99; i++ {
@@ -44,7 +41,7 @@ func TestSortedClockwise(t *testing.T) {
 			shuffled = append(shuffled, item)
 		}
 
-		resorted := SortedClockwise(shuffled)
+		resorted := p.SortedClockwise(shuffled)
 		for i := range vertices {
 			if vertices[i] != resorted[i] {
 				t.Errorf("Expected %v but got %v", vertices, resorted)
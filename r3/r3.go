@@ -170,3 +170,24 @@ func IsCCW(a, b Point, center Point, normal Vector) bool {
 	n := normal.Dot(vc)
 	return n > 0
 }
+
+// Angle returns the angle between this and the given vector in radians.
+func (v Vector) Angle(v2 Vector) float64 {
+	return math.Atan2(v.Cross(v2).Length(), v.Dot(v2))
+}
+
+// Add returns the sum of this and the given vector.
+func (v Vector) Add(v2 Vector) Vector {
+	return Vector{v.X + v2.X, v.Y + v2.Y, v.Z + v2.Z}
+}
+
+// Slerp computes the spherical linear interpolation between the two given unit vectors.
+// The result lies on the great circle arc between v1 and v2, at the fraction t of the arc length from v1.
+func Slerp(v1, v2 Vector, t float64) Vector {
+	theta := v1.Angle(v2)
+	if theta < 1e-12 {
+		return v1
+	}
+	s := math.Sin(theta)
+	return v1.Scale(math.Sin((1-t)*theta) / s).Add(v2.Scale(math.Sin(t*theta) / s))
+}
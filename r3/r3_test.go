@@ -139,3 +139,15 @@ func TestIsCCW(t *testing.T) {
 	}
 
 }
+
+func TestSlerp(t *testing.T) {
+	v1 := Vector{1, 0, 0}
+	v2 := Vector{0, 1, 0}
+
+	for _, f := range []float64{0, 0.25, 0.5, 1} {
+		s := Slerp(v1, v2, f)
+		assertFloatClose(1, s.Length(), t)
+		assertFloatClose(f*math.Pi/2, v1.Angle(s), t)
+		assertFloatClose((1-f)*math.Pi/2, s.Angle(v2), t)
+	}
+}